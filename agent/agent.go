@@ -34,8 +34,6 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
-	"path/filepath"
 	"runtime"
 	"strings"
 	"sync"
@@ -67,10 +65,12 @@ import (
 	"github.com/stellarproject/terraos/cni"
 	"github.com/stellarproject/terraos/config"
 	"github.com/stellarproject/terraos/opts"
+	"github.com/stellarproject/terraos/pkg/configstore"
 	"github.com/stellarproject/terraos/pkg/flux"
 	"github.com/stellarproject/terraos/pkg/iscsi"
+	"github.com/stellarproject/terraos/pkg/secrets"
+	"github.com/stellarproject/terraos/pkg/volume"
 	"github.com/stellarproject/terraos/util"
-	"golang.org/x/sys/unix"
 )
 
 var (
@@ -93,18 +93,41 @@ type network interface {
 const (
 	MediaTypeContainerInfo = "application/vnd.orbit.container.info.v1+json"
 	StatusLabel            = "stellarproject.io/orbit/restart.status"
+	RestartCountLabel      = "stellarproject.io/orbit/restart.count"
+	ExitCodeLabel          = "stellarproject.io/orbit/restart.exit-code"
+	ExitTimeLabel          = "stellarproject.io/orbit/restart.exited-at"
+	StartTimeLabel         = "stellarproject.io/orbit/restart.started-at"
 )
 
 func New(ctx context.Context, c *Config, client *containerd.Client) (*Agent, error) {
 	if err := setupApparmor(); err != nil {
 		return nil, errors.Wrap(err, "setup apparmor")
 	}
+	if err := loadApparmorProfiles(c.ApparmorDir); err != nil {
+		return nil, errors.Wrap(err, "load apparmor profiles")
+	}
 	for _, r := range c.PlainRemotes {
 		plainRemotes[r] = true
 	}
+	volumes, err := volume.NewStore(c.VolumeRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "create volume store")
+	}
+	configs, err := configstore.NewStore(c.ConfigRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "create config store")
+	}
+	secretStore, err := secrets.NewStore(c.SecretRoot, c.NodeKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "create secret store")
+	}
 	a := &Agent{
-		config: c,
-		client: client,
+		config:  c,
+		client:  client,
+		health:  newHealthMonitor(),
+		volumes: volumes,
+		configs: configs,
+		secrets: secretStore,
 	}
 	go a.startSupervisorLoop(namespaces.WithNamespace(ctx, config.DefaultNamespace), c.Interval)
 	return a, nil
@@ -114,6 +137,10 @@ type Agent struct {
 	supervisorMu sync.Mutex
 	client       *containerd.Client
 	config       *Config
+	health       *healthMonitor
+	volumes      *volume.Store
+	configs      *configstore.Store
+	secrets      *secrets.Store
 }
 
 func (a *Agent) Create(ctx context.Context, req *v1.CreateRequest) (*types.Empty, error) {
@@ -127,7 +154,26 @@ func (a *Agent) Create(ctx context.Context, req *v1.CreateRequest) (*types.Empty
 	if req.Container.Security == nil {
 		req.Container.Security = &v1.Security{}
 	}
-	image, err := a.client.Pull(ctx, req.Container.Image, containerd.WithPullUnpack, withPlainRemote(req.Container.Image))
+	if err := validateContainer(req.Container); err != nil {
+		return nil, err
+	}
+	if err := a.validateDependencies(ctx, req.Container); err != nil {
+		return nil, err
+	}
+	if err := a.validateVolumes(req.Container); err != nil {
+		return nil, err
+	}
+	if err := a.validateConfigs(req.Container); err != nil {
+		return nil, err
+	}
+	if err := a.validateSecrets(req.Container); err != nil {
+		return nil, err
+	}
+	image, err := a.client.Pull(ctx, req.Container.Image,
+		containerd.WithPullUnpack,
+		containerd.WithPullSnapshotter(getSnapshotter(req.Container)),
+		withPlainRemote(req.Container.Image),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -140,14 +186,29 @@ func (a *Agent) Create(ctx context.Context, req *v1.CreateRequest) (*types.Empty
 		})
 		return empty, err
 	}
+	o := append(snapshotOpts(req.Container, image),
+		opts.WithOrbitConfig(a.config.Paths(req.Container.ID), req.Container, image),
+		opts.WithRevisionConfig,
+	)
 	container, err := a.client.NewContainer(ctx,
 		req.Container.ID,
-		flux.WithNewSnapshot(image),
-		opts.WithOrbitConfig(a.config.Paths(req.Container.ID), req.Container, image),
+		o...,
 	)
 	if err != nil {
 		return nil, err
 	}
+	dependency, err := a.waitingOn(ctx, req.Container)
+	if err != nil {
+		return nil, err
+	}
+	if dependency != "" {
+		// the supervisor starts the container once its dependencies are ready
+		logrus.WithField("id", container.ID()).WithField("dependency", dependency).Info("waiting on dependency to start")
+		if err := container.Update(ctx, withStatus(containerd.Running)); err != nil {
+			return nil, err
+		}
+		return empty, nil
+	}
 	if err := a.start(ctx, container); err != nil {
 		return nil, err
 	}
@@ -164,7 +225,10 @@ func (a *Agent) Delete(ctx context.Context, req *v1.DeleteRequest) (*types.Empty
 	if err != nil {
 		return nil, errors.Wrap(err, "load container")
 	}
-	if err := a.stop(ctx, container); err != nil {
+	a.supervisorMu.Lock()
+	defer a.supervisorMu.Unlock()
+
+	if err := a.stop(ctx, container, 0); err != nil {
 		return nil, errors.Wrap(err, "stop container")
 	}
 	config, err := opts.GetConfig(ctx, container)
@@ -181,6 +245,10 @@ func (a *Agent) Delete(ctx context.Context, req *v1.DeleteRequest) (*types.Empty
 	if err := container.Delete(ctx, flux.WithRevisionCleanup, opts.WithISCSILogout); err != nil {
 		return nil, err
 	}
+	if err := unmountSecrets(a.config.Paths(id).SecretsPath()); err != nil {
+		return nil, err
+	}
+	a.health.remove(id)
 	return empty, nil
 }
 
@@ -228,20 +296,31 @@ func (a *Agent) info(ctx context.Context, c containerd.Container) (*v1.Container
 		if err != nil {
 			return err
 		}
+		var revisionConfig *v1.Container
+		if d, ok := info.Extensions[opts.RevisionConfig(si.Name)]; ok {
+			if revisionConfig, err = opts.UnmarshalConfig(&d); err != nil {
+				return err
+			}
+		}
 		ss = append(ss, &v1.Snapshot{
 			ID:       si.Name,
 			Created:  si.Created,
 			Previous: si.Labels[flux.PreviousLabel],
 			FsSize:   usage.Size,
+			Image:    si.Labels[flux.ImageLabel],
+			Tag:      si.Labels[flux.TagLabel],
+			Current:  si.Name == info.SnapshotKey,
+			Config:   revisionConfig,
 		})
 		return nil
 	}); err != nil {
 		return nil, err
 	}
-	bindSizes, err := getBindSizes(cfg)
+	volumeSizes, err := a.getVolumeSizes(ctx, cfg)
 	if err != nil {
 		return nil, err
 	}
+	exitCode, exitedAt := getExitStatus(info.Labels)
 	task, err := c.Task(ctx, nil)
 	if err != nil {
 		if errdefs.IsNotFound(err) {
@@ -249,9 +328,12 @@ func (a *Agent) info(ctx context.Context, c containerd.Container) (*v1.Container
 				ID:        c.ID(),
 				Image:     info.Image,
 				Status:    string(containerd.Stopped),
-				FsSize:    usage.Size + bindSizes,
+				FsSize:    usage.Size + volumeSizes,
 				Config:    cfg,
 				Snapshots: ss,
+				Restarts:  uint32(getRestarts(info.Labels)),
+				ExitCode:  exitCode,
+				ExitedAt:  exitedAt,
 			}, nil
 		}
 		return nil, err
@@ -260,6 +342,10 @@ func (a *Agent) info(ctx context.Context, c containerd.Container) (*v1.Container
 	if err != nil {
 		return nil, err
 	}
+	if status.Status == containerd.Stopped {
+		// the exit has not been recorded by the supervisor yet
+		exitCode, exitedAt = status.ExitStatus, status.ExitTime
+	}
 	stats, err := task.Metrics(ctx)
 	if err != nil {
 		return nil, err
@@ -284,10 +370,14 @@ func (a *Agent) info(ctx context.Context, c containerd.Container) (*v1.Container
 		MemoryLimit: limit,
 		PidUsage:    cg.Pids.Current,
 		PidLimit:    cg.Pids.Limit,
-		FsSize:      usage.Size + bindSizes,
+		FsSize:      usage.Size + volumeSizes,
 		Config:      cfg,
 		Snapshots:   ss,
 		IP:          info.Labels[opts.IPLabel],
+		Restarts:    uint32(getRestarts(info.Labels)),
+		ExitCode:    exitCode,
+		ExitedAt:    exitedAt,
+		Health:      a.health.status(c.ID()),
 	}, nil
 }
 
@@ -327,7 +417,7 @@ func (a *Agent) Kill(ctx context.Context, req *v1.KillRequest) (*types.Empty, er
 	if err != nil {
 		return nil, err
 	}
-	if err := task.Kill(ctx, unix.SIGTERM); err != nil {
+	if err := kill(ctx, task, req); err != nil {
 		return nil, err
 	}
 	return empty, nil
@@ -348,6 +438,9 @@ func (a *Agent) Start(ctx context.Context, req *v1.StartRequest) (*types.Empty,
 	if err != nil {
 		return nil, err
 	}
+	if err := container.Update(ctx, withRestarts(0)); err != nil {
+		return nil, err
+	}
 	return empty, a.start(ctx, container)
 }
 
@@ -366,12 +459,35 @@ func (a *Agent) Stop(ctx context.Context, req *v1.StopRequest) (*types.Empty, er
 	if err != nil {
 		return nil, err
 	}
+	a.supervisorMu.Lock()
+	defer a.supervisorMu.Unlock()
 
-	return empty, a.stop(ctx, container)
+	return empty, a.stop(ctx, container, req.Timeout)
 }
 
 func (a *Agent) Update(ctx context.Context, req *v1.UpdateRequest) (*v1.UpdateResponse, error) {
 	ctx = relayContext(ctx)
+	if req.Container == nil {
+		return nil, errors.New("no container provided on update")
+	}
+	if err := validateContainer(req.Container); err != nil {
+		return nil, err
+	}
+	if err := validateWatch(req.Watch, req.Container.Health); err != nil {
+		return nil, err
+	}
+	if err := a.validateDependencies(ctx, req.Container); err != nil {
+		return nil, err
+	}
+	if err := a.validateVolumes(req.Container); err != nil {
+		return nil, err
+	}
+	if err := a.validateConfigs(req.Container); err != nil {
+		return nil, err
+	}
+	if err := a.validateSecrets(req.Container); err != nil {
+		return nil, err
+	}
 	ctx, done, err := a.client.WithLease(ctx)
 	if err != nil {
 		return nil, err
@@ -381,10 +497,29 @@ func (a *Agent) Update(ctx context.Context, req *v1.UpdateRequest) (*v1.UpdateRe
 	if err != nil {
 		return nil, err
 	}
+	current, err := opts.GetConfig(ctx, container)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUserNamespace(current, req.Container); err != nil {
+		return nil, err
+	}
+	resp := &v1.UpdateResponse{
+		Container: req.Container,
+	}
+	if req.Tag == "" && resourcesOnly(current, req.Container) {
+		if err := a.updateResources(ctx, container, req.Container); err != nil {
+			return nil, err
+		}
+		resp.Live = true
+		// the task was not restarted so there is nothing to watch
+		return resp, nil
+	}
 	var changes []change
 	changes = append(changes, &imageUpdateChange{
-		ref:    req.Container.Image,
-		client: a.client,
+		a:   a,
+		c:   req.Container,
+		tag: req.Tag,
 	})
 	changes = append(changes, &configChange{
 		client: a.client,
@@ -392,17 +527,102 @@ func (a *Agent) Update(ctx context.Context, req *v1.UpdateRequest) (*v1.UpdateRe
 		config: a.config,
 	})
 
+	if err := a.update(ctx, container, req.Container, changes); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if _, err := a.prune(ctx, container, a.config.retentionPolicy()); err != nil {
+			logrus.WithError(err).WithField("id", container.ID()).Error("prune revisions")
+		}
+	}()
+	labels, err := container.Labels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// start checking the new health config now instead of on the next reconcile
+	if err := a.monitorHealth(relayContext(context.Background()), container, labels); err != nil {
+		return nil, err
+	}
+	if req.Watch <= 0 {
+		return resp, nil
+	}
+	// the watch runs detached so that a failed update is rolled back even if the client goes away
+	result := make(chan watchResult, 1)
+	go func() {
+		result <- a.watchOrRollback(container, req.Watch)
+	}()
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case r := <-result:
+		if r.err != nil {
+			return nil, r.err
+		}
+		if r.config != nil {
+			resp.Container = r.config
+			resp.RolledBack = true
+			resp.Reason = r.reason
+		}
+	}
+	return resp, nil
+}
+
+type watchResult struct {
+	// config is the restored config if the update was rolled back
+	config *v1.Container
+	reason string
+	err    error
+}
+
+// watchOrRollback watches an updated container and rolls it back to its previous
+// revision if the update failed
+func (a *Agent) watchOrRollback(container containerd.Container, duration time.Duration) watchResult {
+	ctx, done, err := a.client.WithLease(relayContext(context.Background()))
+	if err != nil {
+		return watchResult{err: err}
+	}
+	defer done(ctx)
+	werr := a.watchUpdate(ctx, container, duration)
+	if werr == nil {
+		return watchResult{}
+	}
+	logrus.WithError(werr).WithField("id", container.ID()).Warn("update failed, rolling back")
+	if err := a.rollback(ctx, container, ""); err != nil {
+		logrus.WithError(err).WithField("id", container.ID()).Error("rollback failed update")
+		return watchResult{err: errors.Wrapf(err, "rollback failed update: %s", werr)}
+	}
+	config, err := opts.GetConfig(ctx, container)
+	if err != nil {
+		return watchResult{err: err}
+	}
+	return watchResult{
+		config: config,
+		reason: werr.Error(),
+	}
+}
+
+// update applies the changes to the container and restarts its task to pick them up
+func (a *Agent) update(ctx context.Context, container containerd.Container, config *v1.Container, changes []change) error {
+	// signal the task with the stop signal of the current config
+	signal, timeout, err := a.getStopSignal(ctx, container)
+	if err != nil {
+		return err
+	}
+	// keep the supervisor from restarting the task while it is being updated
+	a.supervisorMu.Lock()
+	defer a.supervisorMu.Unlock()
+
 	var wait <-chan containerd.ExitStatus
 	// bump the task to pickup the changes
 	task, err := container.Task(ctx, nil)
 	if err != nil {
 		if !errdefs.IsNotFound(err) {
-			return nil, err
+			return err
 		}
 	}
 	if task != nil {
 		if wait, err = task.Wait(ctx); err != nil {
-			return nil, err
+			return err
 		}
 	} else {
 		c := make(chan containerd.ExitStatus)
@@ -418,24 +638,58 @@ func (a *Agent) Update(ctx context.Context, req *v1.UpdateRequest) (*v1.UpdateRe
 		if task == nil {
 			return nil
 		}
-		return task.Kill(ctx, unix.SIGTERM)
+		return task.Kill(ctx, signal)
 	})
 	if err != nil {
-		return nil, err
+		return err
+	}
+	a.publish(ctx, container.ID(), EventUpdate, config.Image)
+	if task == nil {
+		return nil
+	}
+	return a.restart(ctx, container, task, wait, timeout)
+}
+
+// watchUpdate watches an updated container for the duration and returns an error
+// if the task exits, is unhealthy, or does not pass its health check in time
+func (a *Agent) watchUpdate(ctx context.Context, container containerd.Container, duration time.Duration) error {
+	config, err := opts.GetConfig(ctx, container)
+	if err != nil {
+		return err
 	}
-	wctx, _ := context.WithTimeout(ctx, 10*time.Second)
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			// the container is not running so there is nothing to watch
+			return nil
+		}
+		return err
+	}
+	wait, err := task.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
 	for {
 		select {
-		case <-wctx.Done():
-			if task != nil {
-				return &v1.UpdateResponse{}, task.Kill(ctx, unix.SIGKILL)
+		case <-ctx.Done():
+			return ctx.Err()
+		case s := <-wait:
+			return errors.Errorf("task exited with status %d", s.ExitCode())
+		case <-ticker.C:
+			if a.health.status(container.ID()) == HealthUnhealthy {
+				return errors.New("health check failed")
 			}
-			return nil, wctx.Err()
-		case <-wait:
-			return &v1.UpdateResponse{}, nil
+		case <-timer.C:
+			if config.Health != nil && a.health.status(container.ID()) != HealthHealthy {
+				return errors.Errorf("health check did not pass within %s", duration)
+			}
+			return nil
 		}
 	}
-	return &v1.UpdateResponse{}, nil
 }
 
 func (a *Agent) Rollback(ctx context.Context, req *v1.RollbackRequest) (*v1.RollbackResponse, error) {
@@ -452,20 +706,52 @@ func (a *Agent) Rollback(ctx context.Context, req *v1.RollbackRequest) (*v1.Roll
 	if err != nil {
 		return nil, err
 	}
-	err = pauseAndRun(ctx, container, func() error {
-		if err := container.Update(ctx, flux.WithRollback, opts.WithRollback); err != nil {
+	if err := a.rollback(ctx, container, req.Revision); err != nil {
+		return nil, err
+	}
+	return &v1.RollbackResponse{}, nil
+}
+
+// rollback restores the container's previous snapshot and config, or the revision
+// with the snapshot id or tag, and restarts its task
+func (a *Agent) rollback(ctx context.Context, container containerd.Container, revision string) error {
+	rollbackOpts := []containerd.UpdateContainerOpts{flux.WithRollback, opts.WithRevisionRestore(a.config.Paths(container.ID()))}
+	if revision != "" {
+		rollbackOpts[0] = flux.WithRevision(revision)
+	}
+	a.supervisorMu.Lock()
+	defer a.supervisorMu.Unlock()
+
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		if !errdefs.IsNotFound(err) {
 			return err
 		}
-		task, err := container.Task(ctx, nil)
-		if err != nil {
+		if err := container.Update(ctx, rollbackOpts...); err != nil {
+			return err
+		}
+		a.publish(ctx, container.ID(), EventRollback, revision)
+		return nil
+	}
+	signal, timeout, err := a.getStopSignal(ctx, container)
+	if err != nil {
+		return err
+	}
+	wait, err := task.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	err = pauseAndRun(ctx, container, func() error {
+		if err := container.Update(ctx, rollbackOpts...); err != nil {
 			return err
 		}
-		return task.Kill(ctx, unix.SIGTERM)
+		return task.Kill(ctx, signal)
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return &v1.RollbackResponse{}, nil
+	a.publish(ctx, container.ID(), EventRollback, revision)
+	return a.restart(ctx, container, task, wait, timeout)
 }
 
 func (a *Agent) Push(ctx context.Context, req *v1.PushRequest) (*types.Empty, error) {
@@ -590,8 +876,12 @@ func (a *Agent) Checkpoint(ctx context.Context, req *v1.CheckpointRequest) (*v1.
 	if _, err := a.client.ImageService().Create(ctx, i); err != nil {
 		return nil, err
 	}
+	a.publish(ctx, req.ID, EventCheckpoint, req.Ref)
 	if req.Exit {
-		if err := a.stop(ctx, container); err != nil {
+		a.supervisorMu.Lock()
+		defer a.supervisorMu.Unlock()
+
+		if err := a.stop(ctx, container, 0); err != nil {
 			return nil, errors.Wrap(err, "stop service")
 		}
 	}
@@ -635,14 +925,27 @@ func (a *Agent) Restore(ctx context.Context, req *v1.RestoreRequest) (*v1.Restor
 	if err != nil {
 		return nil, err
 	}
-	image, err := a.client.Pull(ctx, config.Image, containerd.WithPullUnpack, withPlainRemote(config.Image))
+	if err := a.validateVolumes(config); err != nil {
+		return nil, err
+	}
+	if err := a.validateConfigs(config); err != nil {
+		return nil, err
+	}
+	if err := a.validateSecrets(config); err != nil {
+		return nil, err
+	}
+	image, err := a.client.Pull(ctx, config.Image,
+		containerd.WithPullUnpack,
+		containerd.WithPullSnapshotter(getSnapshotter(config)),
+		withPlainRemote(config.Image),
+	)
 	if err != nil {
 		return nil, err
 	}
-	o := []containerd.NewContainerOpts{
-		flux.WithNewSnapshot(image),
+	o := append(snapshotOpts(config, image),
 		opts.WithOrbitConfig(a.config.Paths(c.ID), config, image),
-	}
+		opts.WithRevisionConfig,
+	)
 	if req.Live {
 		desc, err := getByMediaType(index, images.MediaTypeContainerd1Checkpoint)
 		if err != nil {
@@ -714,6 +1017,7 @@ func (a *Agent) Migrate(ctx context.Context, req *v1.MigrateRequest) (*v1.Migrat
 	}); err != nil {
 		return nil, err
 	}
+	a.publish(ctx, req.ID, EventMigrate, req.To)
 	if req.Delete {
 		if _, err := a.Delete(ctx, &v1.DeleteRequest{
 			ID: req.ID,
@@ -766,16 +1070,50 @@ func (a *Agent) reconcile(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	var dd []stateChange
+	var (
+		configs = make(map[string]*v1.Container)
+		graph   = make(map[string][]string)
+	)
 	for _, c := range containers {
-		d, err := a.getContainerDiff(ctx, c)
+		config, err := opts.GetConfig(ctx, c)
+		if err != nil {
+			logrus.WithError(err).WithField("id", c.ID()).Error("unable to get config")
+			continue
+		}
+		configs[c.ID()] = config
+		graph[c.ID()] = config.DependsOn
+	}
+	var starts, stops []stateChange
+	for _, c := range sortByDependencies(containers, graph) {
+		labels, err := c.Labels(ctx)
+		if err != nil {
+			logrus.WithError(err).WithField("id", c.ID()).Error("unable to get labels")
+			continue
+		}
+		if err := a.monitorHealth(ctx, c, labels); err != nil {
+			logrus.WithError(err).WithField("id", c.ID()).Error("unable to monitor health")
+		}
+		d, err := a.getContainerDiff(ctx, c, labels)
 		if err != nil {
 			logrus.WithError(err).WithField("id", c.ID()).Error("unable to generate supervisor diff")
 			continue
 		}
-		dd = append(dd, d)
+		if _, ok := d.(*stopDiff); ok {
+			// stop dependent containers before their dependencies
+			stops = append([]stateChange{d}, stops...)
+			continue
+		}
+		if config, ok := configs[c.ID()]; ok && len(config.DependsOn) > 0 && startsContainer(d) {
+			d = &dependencyDiff{
+				a:         a,
+				container: c,
+				config:    config,
+				next:      d,
+			}
+		}
+		starts = append(starts, d)
 	}
-	for _, d := range dd {
+	for _, d := range append(stops, starts...) {
 		if err := d.apply(ctx); err != nil {
 			logrus.WithError(err).Error("unable to apply state change")
 		}
@@ -783,11 +1121,21 @@ func (a *Agent) reconcile(ctx context.Context) error {
 	return nil
 }
 
+// restart waits for a signaled task to exit, killing it if it does not exit in time,
+// and starts a new task for the container with its restart count reset
+func (a *Agent) restart(ctx context.Context, container containerd.Container, task containerd.Task, wait <-chan containerd.ExitStatus, timeout time.Duration) error {
+	if err := waitOrKill(ctx, task, wait, timeout); err != nil {
+		return err
+	}
+	if err := container.Update(ctx, withRestarts(0)); err != nil {
+		return err
+	}
+	return a.start(ctx, container)
+}
+
 func (a *Agent) start(ctx context.Context, container containerd.Container) error {
 	logrus.WithField("id", container.ID()).Debug("starting container")
-	if _, _, err := opts.WriteHostsFiles(a.config.Paths(container.ID()).State, container.ID()); err != nil {
-		return errors.Wrap(err, "update hosts files")
-	}
+	a.health.reset(container.ID())
 	if task, err := container.Task(ctx, nil); err == nil {
 		// make sure it's dead
 		task.Kill(ctx, syscall.SIGKILL)
@@ -799,6 +1147,15 @@ func (a *Agent) start(ctx context.Context, container containerd.Container) error
 	if err != nil {
 		return errors.Wrap(err, "get container config")
 	}
+	if _, _, err := opts.WriteHostsFiles(a.config.Paths(container.ID()).State, container.ID(), config.ExtraHosts); err != nil {
+		return errors.Wrap(err, "update hosts files")
+	}
+	if err := a.ensureVolumes(ctx, container, config); err != nil {
+		return errors.Wrap(err, "ensure volumes")
+	}
+	if err := a.mountSecrets(ctx, container, config); err != nil {
+		return errors.Wrap(err, "mount secrets")
+	}
 	desc, err := opts.GetRestoreDesc(ctx, container)
 	if err != nil {
 		return errors.Wrap(err, "get restore descriptor")
@@ -817,10 +1174,10 @@ func (a *Agent) start(ctx context.Context, container containerd.Container) error
 	if ip != "" {
 		logrus.WithField("id", container.ID()).WithField("ip", ip).Debug("setup network interface")
 	}
-	if err := container.Update(ctx, opts.WithIP(ip), opts.WithoutRestore, withStatus(containerd.Running)); err != nil {
+	if err := container.Update(ctx, opts.WithIP(ip), opts.WithoutRestore, withStatus(containerd.Running), withStartTime(time.Now())); err != nil {
 		return errors.Wrap(err, "update container with ip")
 	}
-	task, err := container.NewTask(ctx, cio.BinaryIO(a.config.Logger, nil), opts.WithTaskRestore(desc))
+	task, err := container.NewTask(ctx, cio.BinaryIO(a.config.Logger, a.config.loggerArgs()), opts.WithTaskRestore(desc))
 	if err != nil {
 		return errors.Wrap(err, "create new container task")
 	}
@@ -856,8 +1213,23 @@ func (a *Agent) loginISCSI(ctx context.Context, config *v1.Container) error {
 	return nil
 }
 
-func (a *Agent) stop(ctx context.Context, container containerd.Container) error {
+// stop signals the container's task with its stop signal and kills it
+// after the grace period, a zero timeout uses the container's stop timeout
+func (a *Agent) stop(ctx context.Context, container containerd.Container, timeout time.Duration) (err error) {
 	logrus.WithField("id", container.ID()).Debug("stopping container")
+	// secrets are only kept on the node while the task is running
+	defer func() {
+		if err == nil {
+			err = unmountSecrets(a.config.Paths(container.ID()).SecretsPath())
+		}
+	}()
+	signal, stopTimeout, err := a.getStopSignal(ctx, container)
+	if err != nil {
+		return err
+	}
+	if timeout <= 0 {
+		timeout = stopTimeout
+	}
 	if err := container.Update(ctx, withStatus(containerd.Stopped)); err != nil {
 		return err
 	}
@@ -870,13 +1242,15 @@ func (a *Agent) stop(ctx context.Context, container containerd.Container) error
 			}
 			return err
 		}
-		if err := task.Kill(ctx, syscall.SIGKILL); err != nil {
+		if err := task.Kill(ctx, signal); err != nil {
 			if _, derr := task.Delete(ctx); derr == nil {
 				return nil
 			}
 			return err
 		}
-		<-wait
+		if err := waitOrKill(ctx, task, wait, timeout); err != nil {
+			return err
+		}
 		if _, err := task.Delete(ctx); err != nil {
 			return err
 		}
@@ -884,19 +1258,12 @@ func (a *Agent) stop(ctx context.Context, container containerd.Container) error
 	return nil
 }
 
-func (a *Agent) getContainerDiff(ctx context.Context, container containerd.Container) (stateChange, error) {
-	labels, err := container.Labels(ctx)
-	if err != nil {
-		return nil, err
-	}
+func (a *Agent) getContainerDiff(ctx context.Context, container containerd.Container, labels map[string]string) (stateChange, error) {
 	desiredStatus := containerd.ProcessStatus(labels[StatusLabel])
 	if !isSameStatus(ctx, desiredStatus, container) {
 		switch desiredStatus {
 		case containerd.Running:
-			return &startDiff{
-				a:         a,
-				container: container,
-			}, nil
+			return a.getRestartDiff(ctx, container, labels)
 		case containerd.Stopped:
 			return &stopDiff{
 				a:         a,
@@ -904,9 +1271,51 @@ func (a *Agent) getContainerDiff(ctx context.Context, container containerd.Conta
 			}, nil
 		}
 	}
+	if desiredStatus == containerd.Running && a.health.status(container.ID()) == HealthUnhealthy {
+		return &unhealthyDiff{
+			a:         a,
+			container: container,
+		}, nil
+	}
 	return sameDiff(), nil
 }
 
+func (a *Agent) getRestartDiff(ctx context.Context, container containerd.Container, labels map[string]string) (stateChange, error) {
+	start := &startDiff{
+		a:         a,
+		container: container,
+	}
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			// the task is gone, i.e. after a reboot, so start it without counting a restart
+			return start, nil
+		}
+		return nil, err
+	}
+	status, err := task.Status(ctx)
+	if err != nil || status.Status != containerd.Stopped {
+		return start, nil
+	}
+	config, err := opts.GetConfig(ctx, container)
+	if err != nil {
+		return nil, err
+	}
+	restarts := getRestarts(labels)
+	if startedAt := getStartTime(labels); !startedAt.IsZero() && status.ExitTime.Sub(startedAt) > maxRestartDelay {
+		// the task ran long enough to be considered stable so count restarts from zero
+		restarts = 0
+	}
+	return &restartDiff{
+		a:         a,
+		container: container,
+		labels:    labels,
+		status:    status,
+		restarts:  restarts,
+		restart:   shouldRestart(config.Restart, status.ExitStatus, restarts),
+	}, nil
+}
+
 func (a *Agent) getNetwork(networks []*types.Any) (network, error) {
 	if networks == nil || len(networks) == 0 {
 		return &none{}, nil
@@ -1017,6 +1426,37 @@ func decodeIndex(ctx context.Context, store content.Provider, desc is.Descriptor
 	return &index, nil
 }
 
+func validateContainer(c *v1.Container) error {
+	if err := validateRestartPolicy(c.Restart); err != nil {
+		return err
+	}
+	if err := validateHealthCheck(c.Health); err != nil {
+		return err
+	}
+	if err := validateStopSignal(c); err != nil {
+		return err
+	}
+	if err := validateUpgrade(c); err != nil {
+		return err
+	}
+	if err := validateUserNamespace(c); err != nil {
+		return err
+	}
+	if err := validateSecurity(c); err != nil {
+		return err
+	}
+	if err := validateSysctls(c); err != nil {
+		return err
+	}
+	if err := validateRlimits(c); err != nil {
+		return err
+	}
+	if err := validateExtraHosts(c); err != nil {
+		return err
+	}
+	return nil
+}
+
 func relayContext(ctx context.Context) context.Context {
 	return namespaces.WithNamespace(ctx, config.DefaultNamespace)
 }
@@ -1040,40 +1480,3 @@ func withPlainRemote(ref string) containerd.RemoteOpt {
 		return nil
 	}
 }
-
-func getBindSizes(c *v1.Container) (size int64, _ error) {
-	for _, m := range c.Mounts {
-		if m.Type != "bind" {
-			continue
-		}
-		f, err := os.Open(m.Source)
-		if err != nil {
-			logrus.WithError(err).Warnf("unable to open bind for size %s", m.Source)
-			continue
-		}
-		info, err := f.Stat()
-		if err != nil {
-			f.Close()
-			return size, err
-		}
-		if info.IsDir() {
-			f.Close()
-			if err := filepath.Walk(m.Source, func(path string, wi os.FileInfo, err error) error {
-				if err != nil {
-					return err
-				}
-				if wi.IsDir() {
-					return nil
-				}
-				size += wi.Size()
-				return nil
-			}); err != nil {
-				return size, err
-			}
-			continue
-		}
-		size += info.Size()
-		f.Close()
-	}
-	return size, nil
-}
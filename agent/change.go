@@ -29,10 +29,14 @@ package agent
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/containers"
 	"github.com/containerd/containerd/errdefs"
+	"github.com/gogo/protobuf/proto"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
 	v1 "github.com/stellarproject/terraos/api/v1/orbit"
 	"github.com/stellarproject/terraos/opts"
 	"github.com/stellarproject/terraos/pkg/flux"
@@ -43,16 +47,25 @@ type change interface {
 }
 
 type imageUpdateChange struct {
-	ref    string
-	client *containerd.Client
+	a   *Agent
+	c   *v1.Container
+	tag string
 }
 
 func (c *imageUpdateChange) update(ctx context.Context, container containerd.Container) error {
-	image, err := c.client.Pull(ctx, c.ref, containerd.WithPullUnpack, withPlainRemote(c.ref))
+	info, err := container.Info(ctx)
+	if err != nil {
+		return err
+	}
+	image, err := c.a.client.Pull(ctx, c.c.Image,
+		containerd.WithPullUnpack,
+		containerd.WithPullSnapshotter(info.Snapshotter),
+		withPlainRemote(c.c.Image),
+	)
 	if err != nil {
 		return err
 	}
-	return container.Update(ctx, flux.WithUpgrade(image))
+	return container.Update(ctx, c.a.upgradeOpt(c.c, image), flux.WithTag(c.tag))
 }
 
 type configChange struct {
@@ -66,7 +79,7 @@ func (c *configChange) update(ctx context.Context, container containerd.Containe
 	if err != nil {
 		return err
 	}
-	return container.Update(ctx, opts.WithSetPreviousConfig, opts.WithOrbitConfig(c.config.Paths(c.c.ID), c.c, image))
+	return container.Update(ctx, opts.WithSetPreviousConfig, opts.WithOrbitConfig(c.config.Paths(c.c.ID), c.c, image), opts.WithRevisionConfig)
 }
 
 func pauseAndRun(ctx context.Context, container containerd.Container, fn func() error) error {
@@ -92,3 +105,123 @@ func withImage(i containerd.Image) containerd.UpdateContainerOpts {
 		return nil
 	}
 }
+
+// resourcesOnly returns true when the only changes between the configs are
+// cgroup resources that can be applied to a running task
+func resourcesOnly(current, next *v1.Container) bool {
+	c, n := *current, *next
+	c.Resources, n.Resources = nil, nil
+	if !proto.Equal(&c, &n) {
+		return false
+	}
+	from, to := current.Resources, next.Resources
+	if from == nil {
+		from = &v1.Resources{}
+	}
+	if to == nil {
+		to = &v1.Resources{}
+	}
+	// the oom score and rlimits are set on the process when it is created
+	if from.Score != to.Score || from.NoFile != to.NoFile {
+		return false
+	}
+	return !removesLimit(from, to)
+}
+
+// removesLimit returns true if a limit is unset as an unset value leaves the
+// cgroup's current value in place on a live update
+func removesLimit(from, to *v1.Resources) bool {
+	return (from.Cpus > 0 && to.Cpus == 0) ||
+		(from.Memory > 0 && to.Memory == 0) ||
+		(from.MemoryReservation > 0 && to.MemoryReservation == 0) ||
+		(from.MemorySwap != 0 && to.MemorySwap == 0) ||
+		(from.Pids != 0 && to.Pids == 0) ||
+		(from.CpuShares > 0 && to.CpuShares == 0) ||
+		(from.CpusetCpus != "" && to.CpusetCpus == "") ||
+		(from.CpusetMems != "" && to.CpusetMems == "") ||
+		(from.BlkioWeight > 0 && to.BlkioWeight == 0) ||
+		removesThrottle(from, to) ||
+		removesHugepage(from, to)
+}
+
+// removesThrottle returns true if a device throttle is no longer set for a device
+func removesThrottle(from, to *v1.Resources) bool {
+	f, err := opts.LinuxResources(from)
+	if err != nil {
+		return true
+	}
+	t, err := opts.LinuxResources(to)
+	if err != nil {
+		return true
+	}
+	next := throttledDevices(t.BlockIO)
+	for d := range throttledDevices(f.BlockIO) {
+		if !next[d] {
+			return true
+		}
+	}
+	return false
+}
+
+// throttledDevices returns the throttle type and major:minor of each throttled device
+func throttledDevices(blkio *specs.LinuxBlockIO) map[string]bool {
+	devices := make(map[string]bool)
+	if blkio == nil {
+		return devices
+	}
+	for tpe, throttles := range map[string][]specs.LinuxThrottleDevice{
+		"read_bps":   blkio.ThrottleReadBpsDevice,
+		"write_bps":  blkio.ThrottleWriteBpsDevice,
+		"read_iops":  blkio.ThrottleReadIOPSDevice,
+		"write_iops": blkio.ThrottleWriteIOPSDevice,
+	} {
+		for _, d := range throttles {
+			devices[fmt.Sprintf("%s %d:%d", tpe, d.Major, d.Minor)] = true
+		}
+	}
+	return devices
+}
+
+// removesHugepage returns true if a hugepage size is no longer limited
+func removesHugepage(from, to *v1.Resources) bool {
+	sizes := make(map[string]bool)
+	for _, h := range to.Hugepages {
+		sizes[h.PageSize] = true
+	}
+	for _, h := range from.Hugepages {
+		if !sizes[h.PageSize] {
+			return true
+		}
+	}
+	return false
+}
+
+// updateResources applies the container's resources to the running task without a restart
+func (a *Agent) updateResources(ctx context.Context, container containerd.Container, config *v1.Container) error {
+	a.supervisorMu.Lock()
+	defer a.supervisorMu.Unlock()
+
+	task, err := container.Task(ctx, nil)
+	if err != nil && !errdefs.IsNotFound(err) {
+		return err
+	}
+	if task != nil && config.Resources != nil {
+		resources, err := opts.LinuxResources(config.Resources)
+		if err != nil {
+			return err
+		}
+		if err := task.Update(ctx, containerd.WithResources(resources)); err != nil {
+			return errors.Wrap(err, "update task resources")
+		}
+	}
+	change := &configChange{
+		client: a.client,
+		c:      config,
+		config: a.config,
+	}
+	if err := change.update(ctx, container); err != nil {
+		return err
+	}
+	a.publish(ctx, container.ID(), EventUpdate, "resources")
+	return nil
+}
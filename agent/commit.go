@@ -0,0 +1,226 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/containerd/containerd/content"
+	"github.com/containerd/containerd/diff"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/images"
+	"github.com/containerd/containerd/platforms"
+	"github.com/containerd/containerd/rootfs"
+	"github.com/opencontainers/go-digest"
+	is "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/pkg/errors"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/opts"
+)
+
+const uncompressedLabel = "containerd.io/uncompressed"
+
+// commitManifest includes the media type in the manifest as required by docker registries
+type commitManifest struct {
+	MediaType string `json:"mediaType,omitempty"`
+	is.Manifest
+}
+
+func (a *Agent) Commit(ctx context.Context, req *v1.CommitRequest) (*v1.CommitResponse, error) {
+	ctx = relayContext(ctx)
+	if req.ID == "" {
+		return nil, ErrNoID
+	}
+	if req.Ref == "" {
+		return nil, ErrNoRef
+	}
+	ctx, done, err := a.client.WithLease(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done(ctx)
+	container, err := a.client.LoadContainer(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	info, err := container.Info(ctx)
+	if err != nil {
+		return nil, err
+	}
+	config, err := opts.GetConfigFromInfo(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+	// the rw layer is owned by the remapped ids and would not match the image's layers
+	if getUserNamespace(config) != nil {
+		return nil, errors.Wrapf(errdefs.ErrNotImplemented, "commit %s in a user namespace", req.ID)
+	}
+	base, err := a.client.GetImage(ctx, info.Image)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get base image %s", info.Image)
+	}
+	store := a.client.ContentStore()
+	manifest, err := images.Manifest(ctx, store, base.Target(), platforms.Default())
+	if err != nil {
+		return nil, err
+	}
+	p, err := content.ReadBlob(ctx, store, manifest.Config)
+	if err != nil {
+		return nil, err
+	}
+	var image is.Image
+	if err := json.Unmarshal(p, &image); err != nil {
+		return nil, err
+	}
+
+	var layer is.Descriptor
+	err = pauseAndRun(ctx, container, func() error {
+		layer, err = rootfs.CreateDiff(ctx,
+			info.SnapshotKey,
+			a.client.SnapshotService(info.Snapshotter),
+			a.client.DiffService(),
+			diff.WithReference(fmt.Sprintf("commit-rw-%s", info.SnapshotKey)),
+			diff.WithMediaType(is.MediaTypeImageLayerGzip),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "create rw layer")
+	}
+	layerInfo, err := store.Info(ctx, layer.Digest)
+	if err != nil {
+		return nil, err
+	}
+	diffID, err := digest.Parse(layerInfo.Labels[uncompressedLabel])
+	if err != nil {
+		return nil, errors.Wrap(err, "parse layer diff id")
+	}
+
+	now := time.Now().UTC()
+	image.Created = &now
+	image.RootFS.DiffIDs = append(image.RootFS.DiffIDs, diffID)
+	image.History = append(image.History, is.History{
+		Created:   &now,
+		CreatedBy: fmt.Sprintf("ob commit %s", req.ID),
+	})
+	commitImageConfig(&image.Config, config)
+
+	// keep the media types of the base manifest so the image can be pushed to the same registries
+	if manifest.Config.MediaType == images.MediaTypeDockerSchema2Config {
+		layer.MediaType = images.MediaTypeDockerSchema2LayerGzip
+	}
+	if p, err = json.Marshal(image); err != nil {
+		return nil, err
+	}
+	configDesc, err := writeContent(ctx, store, manifest.Config.MediaType, req.ID+"-commit-config", bytes.NewReader(p))
+	if err != nil {
+		return nil, err
+	}
+	manifest.Config = configDesc
+	manifest.Layers = append(manifest.Layers, layer)
+	labels := map[string]string{
+		"containerd.io/gc.ref.content.config": configDesc.Digest.String(),
+	}
+	for i, l := range manifest.Layers {
+		labels[fmt.Sprintf("containerd.io/gc.ref.content.l.%d", i)] = l.Digest.String()
+	}
+	mediaType := is.MediaTypeImageManifest
+	if manifest.Config.MediaType == images.MediaTypeDockerSchema2Config {
+		mediaType = images.MediaTypeDockerSchema2Manifest
+	}
+	if p, err = json.Marshal(commitManifest{
+		MediaType: mediaType,
+		Manifest:  manifest,
+	}); err != nil {
+		return nil, err
+	}
+	desc, err := writeContent(ctx, store, mediaType, req.ID+"-commit-manifest", bytes.NewReader(p), content.WithLabels(labels))
+	if err != nil {
+		return nil, err
+	}
+	i := images.Image{
+		Name:   req.Ref,
+		Target: desc,
+	}
+	if _, err := a.client.ImageService().Create(ctx, i); err != nil {
+		if !errdefs.IsAlreadyExists(err) {
+			return nil, err
+		}
+		if _, err := a.client.ImageService().Update(ctx, i, "target"); err != nil {
+			return nil, err
+		}
+	}
+	a.publish(ctx, req.ID, EventCommit, req.Ref)
+	if req.Push {
+		if err := a.client.Push(ctx, req.Ref, desc, withPlainRemote(req.Ref)); err != nil {
+			return nil, errors.Wrapf(err, "push %s", req.Ref)
+		}
+	}
+	return &v1.CommitResponse{
+		Digest: desc.Digest.String(),
+	}, nil
+}
+
+// commitImageConfig sets the process configuration of the container on the image config
+func commitImageConfig(ic *is.ImageConfig, c *v1.Container) {
+	if c.Process == nil {
+		return
+	}
+	if len(c.Process.Args) > 0 {
+		ic.Cmd = c.Process.Args
+	}
+	ic.Env = mergeEnv(ic.Env, c.Process.Env)
+	if u := c.Process.User; u != nil {
+		ic.User = fmt.Sprintf("%d:%d", u.Uid, u.Gid)
+	}
+}
+
+// mergeEnv replaces the values in env with the overrides and appends new values
+func mergeEnv(env, overrides []string) []string {
+	out := append([]string(nil), env...)
+	for _, o := range overrides {
+		key := strings.SplitN(o, "=", 2)[0]
+		replaced := false
+		for i, e := range out {
+			if strings.SplitN(e, "=", 2)[0] == key {
+				out[i] = o
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			out = append(out, o)
+		}
+	}
+	return out
+}
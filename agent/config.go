@@ -30,11 +30,13 @@ package agent
 import (
 	"context"
 	"path/filepath"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/containerd/containerd"
 	"github.com/stellarproject/terraos/opts"
+	"github.com/stellarproject/terraos/pkg/flux"
 	"github.com/stellarproject/terraos/util"
 )
 
@@ -46,6 +48,21 @@ type Config struct {
 	PlainRemotes []string      `toml:"plain_remotes"`
 	Interval     time.Duration `toml:"interval"`
 	Logger       string        `toml:"logger"`
+	LoggerOpts   []string      `toml:"logger_opts"`
+	// KeepRevisions and RevisionMaxAge are the retention policy for container revisions
+	KeepRevisions  int           `toml:"keep_revisions"`
+	RevisionMaxAge time.Duration `toml:"revision_max_age"`
+	// BtrfsRoot is the root directory of containerd's btrfs snapshotter
+	BtrfsRoot string `toml:"btrfs_root"`
+	// VolumeRoot is the directory where named volumes are stored
+	VolumeRoot string `toml:"volume_root"`
+	// ConfigRoot is the directory where configs are stored
+	ConfigRoot string `toml:"config_root"`
+	// SecretRoot is the directory where secrets are stored encrypted with the NodeKey
+	SecretRoot string `toml:"secret_root"`
+	NodeKey    string `toml:"node_key"`
+	// ApparmorDir is a directory of apparmor profiles loaded on start
+	ApparmorDir string `toml:"apparmor_dir"`
 
 	ip    string
 	ipErr error
@@ -62,9 +79,34 @@ func (c *Config) IP() (string, error) {
 	return c.ip, nil
 }
 
+// loggerArgs returns the arguments passed to the logging binary
+// from the key=value logger options and the agent's state directory
+func (c *Config) loggerArgs() map[string]string {
+	args := map[string]string{
+		"-state": c.State,
+	}
+	for _, o := range c.LoggerOpts {
+		kv := strings.SplitN(o, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		args["-"+kv[0]] = kv[1]
+	}
+	return args
+}
+
+func (c *Config) retentionPolicy() flux.RetentionPolicy {
+	return flux.RetentionPolicy{
+		Keep:   c.KeepRevisions,
+		MaxAge: c.RevisionMaxAge,
+	}
+}
+
 func (c *Config) Paths(id string) opts.Paths {
 	return opts.Paths{
-		State: filepath.Join(c.State, id),
+		State:   filepath.Join(c.State, id),
+		Configs: c.ConfigRoot,
+		Volumes: c.VolumeRoot,
 	}
 }
 
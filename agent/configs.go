@@ -0,0 +1,252 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/opts"
+	"github.com/stellarproject/terraos/pkg/configstore"
+)
+
+func (a *Agent) CreateConfig(ctx context.Context, req *v1.CreateConfigRequest) (*v1.CreateConfigResponse, error) {
+	c, err := a.configs.Create(req.Name, req.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateConfigResponse{
+		Config: toConfig(c),
+	}, nil
+}
+
+func (a *Agent) DeleteConfig(ctx context.Context, req *v1.DeleteConfigRequest) (*types.Empty, error) {
+	ctx = relayContext(ctx)
+	users, err := a.configUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ids := users[req.Name]; len(ids) > 0 {
+		return nil, errors.Wrapf(errdefs.ErrFailedPrecondition, "config %s is used by %s", req.Name, strings.Join(ids, ", "))
+	}
+	if err := a.configs.Remove(req.Name); err != nil {
+		return nil, err
+	}
+	return empty, nil
+}
+
+func (a *Agent) GetConfig(ctx context.Context, req *v1.GetConfigRequest) (*v1.GetConfigResponse, error) {
+	ctx = relayContext(ctx)
+	c, err := a.configs.Get(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := a.configs.Data(req.Name, req.Version)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := a.configs.Versions(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	users, err := a.configUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info := toConfig(c)
+	info.Containers = users[c.Name]
+	info.Versions = versions
+	return &v1.GetConfigResponse{
+		Config: info,
+		Data:   data,
+	}, nil
+}
+
+func (a *Agent) ListConfigs(ctx context.Context, req *v1.ListConfigsRequest) (*v1.ListConfigsResponse, error) {
+	ctx = relayContext(ctx)
+	configs, err := a.configs.List()
+	if err != nil {
+		return nil, err
+	}
+	users, err := a.configUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var resp v1.ListConfigsResponse
+	for _, c := range configs {
+		info := toConfig(c)
+		info.Containers = users[c.Name]
+		resp.Configs = append(resp.Configs, info)
+	}
+	return &resp, nil
+}
+
+// UpdateConfig writes a new version of the config and restarts or signals
+// the running containers that use the config
+func (a *Agent) UpdateConfig(ctx context.Context, req *v1.UpdateConfigRequest) (*v1.UpdateConfigResponse, error) {
+	ctx = relayContext(ctx)
+	current, err := a.configs.Get(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	c, err := a.configs.Update(req.Name, req.Data)
+	if err != nil {
+		return nil, err
+	}
+	resp := &v1.UpdateConfigResponse{
+		Config: toConfig(c),
+	}
+	if c.Version == current.Version {
+		return resp, nil
+	}
+	containers, err := a.client.Containers(ctx, fmt.Sprintf("labels.%q", StatusLabel))
+	if err != nil {
+		return nil, err
+	}
+	// the new version is already live so reload every container before reporting failures
+	var failed []string
+	for _, container := range containers {
+		config, err := opts.GetConfig(ctx, container)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: get config: %s", container.ID(), err))
+			continue
+		}
+		for _, f := range config.Configs {
+			if f.ID != req.Name {
+				continue
+			}
+			reloaded, err := a.reloadConfig(ctx, container, f)
+			if err != nil {
+				failed = append(failed, fmt.Sprintf("%s: %s", container.ID(), err))
+				break
+			}
+			if reloaded {
+				resp.Reloaded = append(resp.Reloaded, container.ID())
+			}
+			break
+		}
+	}
+	if len(failed) > 0 {
+		return nil, errors.Errorf("config %s updated to version %d but not reloaded in %s", req.Name, c.Version, strings.Join(failed, "; "))
+	}
+	return resp, nil
+}
+
+// reloadConfig signals or restarts the container's running task for the changed config
+func (a *Agent) reloadConfig(ctx context.Context, container containerd.Container, f *v1.ConfigFile) (bool, error) {
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	status, err := task.Status(ctx)
+	if err != nil {
+		return false, err
+	}
+	if status.Status != containerd.Running {
+		return false, nil
+	}
+	logrus.WithField("id", container.ID()).WithField("config", f.ID).Info("reloading config")
+	if f.Signal != "" {
+		signal, err := parseSignal(f.Signal)
+		if err != nil {
+			return false, err
+		}
+		return true, task.Kill(ctx, signal)
+	}
+	signal, timeout, err := a.getStopSignal(ctx, container)
+	if err != nil {
+		return false, err
+	}
+	// keep the supervisor from restarting the task while it is being restarted
+	a.supervisorMu.Lock()
+	defer a.supervisorMu.Unlock()
+
+	wait, err := task.Wait(ctx)
+	if err != nil {
+		return false, err
+	}
+	if err := task.Kill(ctx, signal); err != nil {
+		return false, err
+	}
+	return true, a.restart(ctx, container, task, wait, timeout)
+}
+
+// configUsers returns the ids of the containers using each config
+func (a *Agent) configUsers(ctx context.Context) (map[string][]string, error) {
+	containers, err := a.client.Containers(ctx, fmt.Sprintf("labels.%q", StatusLabel))
+	if err != nil {
+		return nil, err
+	}
+	users := make(map[string][]string)
+	for _, c := range containers {
+		config, err := opts.GetConfig(ctx, c)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get config %s", c.ID())
+		}
+		for _, f := range config.Configs {
+			users[f.ID] = append(users[f.ID], c.ID())
+		}
+	}
+	return users, nil
+}
+
+// validateConfigs ensures that the configs mounted by the container exist
+func (a *Agent) validateConfigs(c *v1.Container) error {
+	for _, f := range c.Configs {
+		if _, err := a.configs.Get(f.ID); err != nil {
+			return err
+		}
+		if f.Signal != "" {
+			if _, err := parseSignal(f.Signal); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func toConfig(c *configstore.Config) *v1.Config {
+	return &v1.Config{
+		Name:    c.Name,
+		Version: c.Version,
+		Digest:  c.Digest.String(),
+		FsSize:  c.Size,
+		Created: c.Created,
+		Updated: c.Updated,
+	}
+}
@@ -0,0 +1,208 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/opts"
+)
+
+// validateDependencies rejects a container that depends on a container that does not
+// exist or whose dependencies form a cycle with the containers already on the agent
+func (a *Agent) validateDependencies(ctx context.Context, c *v1.Container) error {
+	if len(c.DependsOn) == 0 {
+		return nil
+	}
+	containers, err := a.client.Containers(ctx, fmt.Sprintf("labels.%q", StatusLabel))
+	if err != nil {
+		return err
+	}
+	graph := make(map[string][]string)
+	for _, container := range containers {
+		config, err := opts.GetConfig(ctx, container)
+		if err != nil {
+			return errors.Wrapf(err, "get config %s", container.ID())
+		}
+		graph[container.ID()] = config.DependsOn
+	}
+	for _, d := range c.DependsOn {
+		if _, ok := graph[d]; !ok && d != c.ID {
+			return errors.Wrapf(errdefs.ErrNotFound, "dependency %s", d)
+		}
+	}
+	graph[c.ID] = c.DependsOn
+	return checkCycle(c.ID, graph)
+}
+
+func checkCycle(id string, graph map[string][]string) error {
+	var (
+		path    []string
+		visited = make(map[string]bool)
+		visit   func(string) error
+	)
+	visit = func(n string) error {
+		for i, p := range path {
+			if p == n {
+				return errors.Errorf("dependency cycle %s", strings.Join(append(path[i:], n), " -> "))
+			}
+		}
+		if visited[n] {
+			return nil
+		}
+		visited[n] = true
+		path = append(path, n)
+		for _, d := range graph[n] {
+			if err := visit(d); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		return nil
+	}
+	return visit(id)
+}
+
+// sortByDependencies orders the containers so that dependencies come before the
+// containers that depend on them, keeping the existing order where possible
+func sortByDependencies(containers []containerd.Container, graph map[string][]string) []containerd.Container {
+	var (
+		sorted  []containerd.Container
+		pending = make(map[string]bool)
+		done    = make(map[string]bool)
+	)
+	for _, c := range containers {
+		pending[c.ID()] = true
+	}
+	ready := func(id string) bool {
+		for _, d := range graph[id] {
+			// dependencies that do not exist on the agent do not affect the order
+			if pending[d] && !done[d] {
+				return false
+			}
+		}
+		return true
+	}
+	for len(sorted) < len(containers) {
+		progress := false
+		for _, c := range containers {
+			if done[c.ID()] || !ready(c.ID()) {
+				continue
+			}
+			done[c.ID()] = true
+			sorted = append(sorted, c)
+			progress = true
+		}
+		if !progress {
+			// cycles are rejected on create but keep the remaining containers
+			for _, c := range containers {
+				if !done[c.ID()] {
+					done[c.ID()] = true
+					sorted = append(sorted, c)
+				}
+			}
+		}
+	}
+	return sorted
+}
+
+func startsContainer(d stateChange) bool {
+	switch t := d.(type) {
+	case *startDiff:
+		return true
+	case *restartDiff:
+		return t.restart
+	}
+	return false
+}
+
+// dependencyDiff delays a start of the container until its dependencies are running
+type dependencyDiff struct {
+	a         *Agent
+	container containerd.Container
+	config    *v1.Container
+	next      stateChange
+}
+
+func (s *dependencyDiff) apply(ctx context.Context) error {
+	id, err := s.a.waitingOn(ctx, s.config)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		logrus.WithField("id", s.container.ID()).WithField("dependency", id).Debug("waiting on dependency")
+		return nil
+	}
+	return s.next.apply(ctx)
+}
+
+// waitingOn returns the first dependency of the container that is not ready,
+// dependencies that were deleted after the container was created are not waited on
+func (a *Agent) waitingOn(ctx context.Context, config *v1.Container) (string, error) {
+	for _, id := range config.DependsOn {
+		container, err := a.client.LoadContainer(ctx, id)
+		if err != nil {
+			if errdefs.IsNotFound(err) {
+				continue
+			}
+			return "", err
+		}
+		task, err := container.Task(ctx, nil)
+		if err != nil {
+			if errdefs.IsNotFound(err) {
+				return id, nil
+			}
+			return "", err
+		}
+		status, err := task.Status(ctx)
+		if err != nil {
+			return "", err
+		}
+		if status.Status != containerd.Running {
+			return id, nil
+		}
+		if !config.WaitHealthy {
+			continue
+		}
+		dc, err := opts.GetConfig(ctx, container)
+		if err != nil {
+			return "", err
+		}
+		if dc.Health != nil && a.health.status(id) != HealthHealthy {
+			return id, nil
+		}
+	}
+	return "", nil
+}
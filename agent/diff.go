@@ -0,0 +1,74 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"context"
+	"os"
+
+	"github.com/containerd/continuity/fs"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/pkg/flux"
+)
+
+func (a *Agent) Diff(ctx context.Context, req *v1.DiffRequest) (*v1.DiffResponse, error) {
+	ctx = relayContext(ctx)
+	if req.ID == "" {
+		return nil, ErrNoID
+	}
+	ctx, done, err := a.client.WithLease(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done(ctx)
+	container, err := a.client.LoadContainer(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	info, err := container.Info(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var resp v1.DiffResponse
+	if err := flux.Changes(ctx, a.client, &info, req.From, req.To, func(kind fs.ChangeKind, path string, _ os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if kind == fs.ChangeKindUnmodified {
+			return nil
+		}
+		resp.Changes = append(resp.Changes, &v1.Change{
+			Kind: kind.String(),
+			Path: path,
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,148 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	eventstypes "github.com/containerd/containerd/api/events"
+	"github.com/containerd/containerd/events"
+	"github.com/containerd/typeurl"
+	"github.com/sirupsen/logrus"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/config"
+)
+
+const (
+	EventCreate     = "create"
+	EventStart      = "start"
+	EventExit       = "exit"
+	EventOOM        = "oom"
+	EventUpdate     = "update"
+	EventRollback   = "rollback"
+	EventCheckpoint = "checkpoint"
+	EventCommit     = "commit"
+	EventMigrate    = "migrate"
+	EventDelete     = "delete"
+
+	eventTopicPrefix = "/orbit/"
+)
+
+func (a *Agent) Events(req *v1.EventsRequest, stream v1.Agent_EventsServer) error {
+	ctx := relayContext(stream.Context())
+	ch, errs := a.client.EventService().Subscribe(ctx, fmt.Sprintf("namespace==%s", config.DefaultNamespace))
+	for {
+		select {
+		case err := <-errs:
+			return err
+		case envelope, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			e, err := toEvent(envelope)
+			if err != nil {
+				logrus.WithError(err).WithField("topic", envelope.Topic).Warn("unable to convert event")
+				continue
+			}
+			if e == nil || !matchEvent(req, e) {
+				continue
+			}
+			if err := stream.Send(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// publish sends an agent originated event through containerd so that
+// it is received by subscribers along with the containerd events
+func (a *Agent) publish(ctx context.Context, id, tpe, message string) {
+	e := &v1.Event{
+		ID:        id,
+		Type:      tpe,
+		Timestamp: time.Now(),
+		Message:   message,
+	}
+	if err := a.client.EventService().Publish(ctx, eventTopicPrefix+tpe, e); err != nil {
+		logrus.WithError(err).WithField("id", id).Warnf("unable to publish %s event", tpe)
+	}
+}
+
+func toEvent(envelope *events.Envelope) (*v1.Event, error) {
+	v, err := typeurl.UnmarshalAny(envelope.Event)
+	if err != nil {
+		return nil, err
+	}
+	e := &v1.Event{
+		Timestamp: envelope.Timestamp,
+	}
+	switch t := v.(type) {
+	case *v1.Event:
+		return t, nil
+	case *eventstypes.ContainerCreate:
+		e.ID = t.ID
+		e.Type = EventCreate
+		e.Message = t.Image
+	case *eventstypes.ContainerDelete:
+		e.ID = t.ID
+		e.Type = EventDelete
+	case *eventstypes.TaskStart:
+		e.ID = t.ContainerID
+		e.Type = EventStart
+	case *eventstypes.TaskExit:
+		// ignore exec'd processes such as health checks
+		if t.ID != t.ContainerID {
+			return nil, nil
+		}
+		e.ID = t.ContainerID
+		e.Type = EventExit
+		e.ExitCode = t.ExitStatus
+	case *eventstypes.TaskOOM:
+		e.ID = t.ContainerID
+		e.Type = EventOOM
+	default:
+		return nil, nil
+	}
+	return e, nil
+}
+
+func matchEvent(req *v1.EventsRequest, e *v1.Event) bool {
+	return (len(req.IDs) == 0 || contains(req.IDs, e.ID)) &&
+		(len(req.Types) == 0 || contains(req.Types, e.Type))
+}
+
+func contains(l []string, s string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
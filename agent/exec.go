@@ -0,0 +1,187 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/cio"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+)
+
+func (a *Agent) Exec(stream v1.Agent_ExecServer) error {
+	ctx := relayContext(stream.Context())
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	if req.ID == "" {
+		return ErrNoID
+	}
+	if len(req.Args) == 0 {
+		return errors.New("no args provided to exec")
+	}
+	container, err := a.client.LoadContainer(ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	spec, err := container.Spec(ctx)
+	if err != nil {
+		return err
+	}
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		return err
+	}
+	pspec := spec.Process
+	pspec.Terminal = req.Tty
+	pspec.Args = req.Args
+	pspec.Env = append(pspec.Env, req.Env...)
+
+	var (
+		sender         = &execSender{stream: stream}
+		stdinR, stdinW = io.Pipe()
+		stdin          = &stdinCloser{r: stdinR}
+	)
+	streams := []cio.Opt{
+		cio.WithStreams(stdin, sender.writer(false), sender.writer(true)),
+	}
+	if req.Tty {
+		streams = append(streams, cio.WithTerminal)
+	}
+	process, err := task.Exec(ctx, uuid.New().String(), pspec, cio.NewCreator(streams...))
+	if err != nil {
+		return err
+	}
+	defer process.Delete(relayContext(context.Background()), containerd.WithProcessKill)
+	stdin.closer = func() {
+		process.CloseIO(ctx, containerd.WithStdinCloser)
+	}
+	statusC, err := process.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	if err := process.Start(ctx); err != nil {
+		return err
+	}
+	go func() {
+		defer stdinW.Close()
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			if len(req.Stdin) > 0 {
+				if _, err := stdinW.Write(req.Stdin); err != nil {
+					return
+				}
+			}
+			if req.Resize != nil {
+				if err := process.Resize(ctx, req.Resize.Width, req.Resize.Height); err != nil {
+					logrus.WithError(err).WithField("id", req.ID).Warn("resize exec console")
+				}
+			}
+			if req.CloseStdin {
+				return
+			}
+		}
+	}()
+	var status containerd.ExitStatus
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case status = <-statusC:
+	}
+	code, _, err := status.Result()
+	if err != nil {
+		return err
+	}
+	// make sure all output is sent before the exit
+	process.IO().Wait()
+	return sender.send(&v1.ExecResponse{
+		Exited:   true,
+		ExitCode: code,
+	})
+}
+
+// execSender serializes the sends of stdout and stderr on the stream
+type execSender struct {
+	mu     sync.Mutex
+	stream v1.Agent_ExecServer
+}
+
+func (s *execSender) send(r *v1.ExecResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stream.Send(r)
+}
+
+func (s *execSender) writer(stderr bool) io.Writer {
+	return execWriterFunc(func(p []byte) (int, error) {
+		data := make([]byte, len(p))
+		copy(data, p)
+		r := &v1.ExecResponse{}
+		if stderr {
+			r.Stderr = data
+		} else {
+			r.Stdout = data
+		}
+		if err := s.send(r); err != nil {
+			return 0, err
+		}
+		return len(p), nil
+	})
+}
+
+type execWriterFunc func([]byte) (int, error)
+
+func (f execWriterFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
+
+// stdinCloser closes the process's stdin once the client's stdin is drained
+type stdinCloser struct {
+	r      io.Reader
+	closer func()
+}
+
+func (s *stdinCloser) Read(p []byte) (int, error) {
+	n, err := s.r.Read(p)
+	if err == io.EOF {
+		if s.closer != nil {
+			s.closer()
+		}
+	}
+	return n, err
+}
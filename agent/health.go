@@ -0,0 +1,304 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/cio"
+	"github.com/gogo/protobuf/proto"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/opts"
+)
+
+const (
+	HealthStarting  = "starting"
+	HealthHealthy   = "healthy"
+	HealthUnhealthy = "unhealthy"
+
+	defaultHealthInterval = 30 * time.Second
+	defaultHealthTimeout  = 5 * time.Second
+	defaultHealthRetries  = 3
+)
+
+var errTaskNotRunning = errors.New("task not running")
+
+func validateHealthCheck(h *v1.HealthCheck) error {
+	if h == nil {
+		return nil
+	}
+	switch h.Type {
+	case "exec":
+		if len(h.Args) == 0 {
+			return errors.New("exec health check requires args")
+		}
+	case "tcp", "http":
+		if h.Port == 0 {
+			return errors.Errorf("%s health check requires a port", h.Type)
+		}
+	default:
+		return errors.Errorf("invalid health check type %q", h.Type)
+	}
+	return nil
+}
+
+// validateWatch ensures an update is watched long enough for the health check to run
+func validateWatch(watch time.Duration, h *v1.HealthCheck) error {
+	if watch <= 0 || h == nil {
+		return nil
+	}
+	if interval := healthInterval(h); watch <= interval {
+		return errors.Errorf("watch %s must be longer than the health check interval %s", watch, interval)
+	}
+	return nil
+}
+
+func healthInterval(h *v1.HealthCheck) time.Duration {
+	if h.Interval == 0 {
+		return defaultHealthInterval
+	}
+	return h.Interval
+}
+
+type healthState struct {
+	config   *v1.HealthCheck
+	status   string
+	failures uint32
+	cancel   func()
+}
+
+// healthMonitor runs the health checks for running containers and tracks their results
+type healthMonitor struct {
+	mu     sync.Mutex
+	checks map[string]*healthState
+}
+
+func newHealthMonitor() *healthMonitor {
+	return &healthMonitor{
+		checks: make(map[string]*healthState),
+	}
+}
+
+// monitor starts the health check for a container, replacing any existing check if the config changed
+func (m *healthMonitor) monitor(ctx context.Context, id string, config *v1.HealthCheck, check func(context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s, ok := m.checks[id]; ok {
+		if proto.Equal(s.config, config) {
+			return
+		}
+		s.cancel()
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s := &healthState{
+		config: config,
+		status: HealthStarting,
+		cancel: cancel,
+	}
+	m.checks[id] = s
+	go m.run(ctx, id, s, check)
+}
+
+func (m *healthMonitor) run(ctx context.Context, id string, s *healthState, check func(context.Context) error) {
+	var (
+		interval = healthInterval(s.config)
+		timeout  = s.config.Timeout
+		retries  = s.config.Retries
+	)
+	if timeout == 0 {
+		timeout = defaultHealthTimeout
+	}
+	if retries == 0 {
+		retries = defaultHealthRetries
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			cctx, cancel := context.WithTimeout(ctx, timeout)
+			err := check(cctx)
+			cancel()
+			if err == errTaskNotRunning {
+				continue
+			}
+			m.mu.Lock()
+			if err != nil {
+				s.failures++
+				logrus.WithError(err).WithField("id", id).WithField("failures", s.failures).Warn("health check failed")
+				if s.failures >= retries {
+					s.status = HealthUnhealthy
+				}
+			} else {
+				s.failures = 0
+				s.status = HealthHealthy
+			}
+			m.mu.Unlock()
+		}
+	}
+}
+
+// reset returns a container's health to starting, i.e. after a new task is started
+func (m *healthMonitor) reset(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s, ok := m.checks[id]; ok {
+		s.status = HealthStarting
+		s.failures = 0
+	}
+}
+
+func (m *healthMonitor) remove(id string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s, ok := m.checks[id]; ok {
+		s.cancel()
+		delete(m.checks, id)
+	}
+}
+
+func (m *healthMonitor) status(id string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s, ok := m.checks[id]; ok {
+		return s.status
+	}
+	return ""
+}
+
+func (a *Agent) monitorHealth(ctx context.Context, container containerd.Container, labels map[string]string) error {
+	config, err := opts.GetConfig(ctx, container)
+	if err != nil {
+		return err
+	}
+	if config.Health == nil || containerd.ProcessStatus(labels[StatusLabel]) != containerd.Running {
+		a.health.remove(container.ID())
+		return nil
+	}
+	a.health.monitor(ctx, container.ID(), config.Health, a.healthCheck(container, config.Health))
+	return nil
+}
+
+func (a *Agent) healthCheck(container containerd.Container, h *v1.HealthCheck) func(context.Context) error {
+	return func(ctx context.Context) error {
+		task, err := container.Task(ctx, nil)
+		if err != nil {
+			return errTaskNotRunning
+		}
+		status, err := task.Status(ctx)
+		if err != nil || status.Status != containerd.Running {
+			return errTaskNotRunning
+		}
+		if h.Type == "exec" {
+			return execCheck(ctx, container, task, h.Args)
+		}
+		labels, err := container.Labels(ctx)
+		if err != nil {
+			return err
+		}
+		ip := labels[opts.IPLabel]
+		if ip == "" {
+			return errors.New("container has no ip to check")
+		}
+		address := net.JoinHostPort(ip, strconv.Itoa(int(h.Port)))
+		switch h.Type {
+		case "tcp":
+			var d net.Dialer
+			conn, err := d.DialContext(ctx, "tcp", address)
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		case "http":
+			r, err := http.NewRequest(http.MethodGet, fmt.Sprintf("http://%s%s", address, h.Path), nil)
+			if err != nil {
+				return err
+			}
+			resp, err := http.DefaultClient.Do(r.WithContext(ctx))
+			if err != nil {
+				return err
+			}
+			resp.Body.Close()
+			if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+				return errors.Errorf("http status %d", resp.StatusCode)
+			}
+			return nil
+		}
+		return errors.Errorf("invalid health check type %q", h.Type)
+	}
+}
+
+func execCheck(ctx context.Context, container containerd.Container, task containerd.Task, args []string) error {
+	spec, err := container.Spec(ctx)
+	if err != nil {
+		return err
+	}
+	pspec := *spec.Process
+	pspec.Terminal = false
+	pspec.Args = args
+
+	process, err := task.Exec(ctx, "health-"+uuid.New().String(), &pspec, cio.NullIO)
+	if err != nil {
+		return err
+	}
+	// delete with a new context incase the check timed out
+	defer process.Delete(relayContext(context.Background()), containerd.WithProcessKill)
+
+	wait, err := process.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	if err := process.Start(ctx); err != nil {
+		return err
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case status := <-wait:
+		code, _, err := status.Result()
+		if err != nil {
+			return err
+		}
+		if code != 0 {
+			return errors.Errorf("health check exited with %d", code)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,170 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/coreos/go-systemd/journal"
+	"github.com/pkg/errors"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/config"
+)
+
+const (
+	StreamStdout = "stdout"
+	StreamStderr = "stderr"
+
+	fileLogger = "orbit-filelog"
+)
+
+// journalEntry is a journal entry written by orbit-log as output by journalctl -o json
+type journalEntry struct {
+	Message   json.RawMessage `json:"MESSAGE"`
+	Priority  string          `json:"PRIORITY"`
+	Timestamp string          `json:"__REALTIME_TIMESTAMP"`
+}
+
+func (a *Agent) Logs(req *v1.LogsRequest, stream v1.Agent_LogsServer) error {
+	ctx := relayContext(stream.Context())
+	if req.ID == "" {
+		return ErrNoID
+	}
+	// only orbit-log writes to the journal
+	if filepath.Base(a.config.Logger) == fileLogger {
+		return errors.Wrapf(errdefs.ErrNotImplemented, "logs are written to %s by %s and cannot be read by the agent",
+			filepath.Join(a.config.State, req.ID, "container.log"), fileLogger)
+	}
+	args := []string{
+		"--no-pager",
+		"-o", "json",
+		"-t", fmt.Sprintf("%s:%s", config.DefaultNamespace, req.ID),
+	}
+	if req.Follow {
+		args = append(args, "-f")
+	}
+	if req.Tail > 0 {
+		args = append(args, "-n", strconv.FormatInt(req.Tail, 10))
+	}
+	if !req.Since.IsZero() {
+		args = append(args, fmt.Sprintf("--since=@%d", req.Since.Unix()))
+	}
+	if !req.Until.IsZero() {
+		args = append(args, fmt.Sprintf("--until=@%d", req.Until.Unix()))
+	}
+	// matches on the same field are or'd by journalctl
+	if req.Stdout || !req.Stderr {
+		args = append(args, fmt.Sprintf("PRIORITY=%d", journal.PriInfo))
+	}
+	if req.Stderr || !req.Stdout {
+		args = append(args, fmt.Sprintf("PRIORITY=%d", journal.PriErr))
+	}
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "journalctl", args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return errors.Wrap(err, "start journalctl")
+	}
+	if err := sendJournal(out, stream); err != nil {
+		cmd.Process.Kill()
+		cmd.Wait()
+		return err
+	}
+	if err := cmd.Wait(); err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return errors.Wrapf(err, "journalctl: %s", stderr.Bytes())
+	}
+	return nil
+}
+
+func sendJournal(r io.Reader, stream v1.Agent_LogsServer) error {
+	dec := json.NewDecoder(r)
+	for {
+		var e journalEntry
+		if err := dec.Decode(&e); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return errors.Wrap(err, "decode journal entry")
+		}
+		entry, err := toLogEntry(&e)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(entry); err != nil {
+			return err
+		}
+	}
+}
+
+func toLogEntry(e *journalEntry) (*v1.LogEntry, error) {
+	usec, err := strconv.ParseInt(e.Timestamp, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse journal timestamp")
+	}
+	entry := &v1.LogEntry{
+		Timestamp: time.Unix(0, usec*int64(time.Microsecond)),
+		Stream:    StreamStdout,
+	}
+	if e.Priority == strconv.Itoa(int(journal.PriErr)) {
+		entry.Stream = StreamStderr
+	}
+	// the journal outputs messages that are not valid utf8 as an array of bytes
+	if len(e.Message) > 0 && e.Message[0] == '[' {
+		var data []byte
+		var raw []int
+		if err := json.Unmarshal(e.Message, &raw); err != nil {
+			return nil, err
+		}
+		for _, b := range raw {
+			data = append(data, byte(b))
+		}
+		entry.Data = data
+	} else {
+		var s string
+		if err := json.Unmarshal(e.Message, &s); err != nil {
+			return nil, err
+		}
+		entry.Data = []byte(s)
+	}
+	return entry, nil
+}
@@ -0,0 +1,199 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/opencontainers/runc/libcontainer/user"
+	"github.com/pkg/errors"
+	"github.com/prometheus/procfs"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/opts"
+	"golang.org/x/sys/unix"
+)
+
+func (a *Agent) Top(ctx context.Context, req *v1.TopRequest) (*v1.TopResponse, error) {
+	ctx = relayContext(ctx)
+	if req.ID == "" {
+		return nil, ErrNoID
+	}
+	container, err := a.client.LoadContainer(ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	pids, err := task.Pids(ctx)
+	if err != nil {
+		return nil, err
+	}
+	config, err := opts.GetConfig(ctx, container)
+	if err != nil {
+		return nil, err
+	}
+	users, err := containerUsers(task.Pid())
+	if err != nil {
+		return nil, errors.Wrap(err, "read container users")
+	}
+	var resp v1.TopResponse
+	for _, p := range pids {
+		info, err := getProcessInfo(p.Pid)
+		if err != nil {
+			if os.IsNotExist(errors.Cause(err)) {
+				// the process exited after the pids were listed
+				continue
+			}
+			return nil, errors.Wrapf(err, "get process info %d", p.Pid)
+		}
+		// map the host ids back into the container's user namespace, processes
+		// outside of the namespace keep their host ids without a user name
+		uid, gid, ok := opts.ContainerUser(getUserNamespace(config), info.Uid, info.Gid)
+		if ok {
+			info.Uid, info.Gid = uid, gid
+			info.User = users[int(uid)]
+		}
+		resp.Processes = append(resp.Processes, info)
+	}
+	return &resp, nil
+}
+
+// containerUsers returns the user names by uid from the /etc/passwd in the task's root
+func containerUsers(pid uint32) (map[int]string, error) {
+	path := filepath.Join(procfs.DefaultMountPoint, strconv.Itoa(int(pid)), "root", "etc", "passwd")
+	entries, err := user.ParsePasswdFile(path)
+	if err != nil {
+		if os.IsNotExist(errors.Cause(err)) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	users := make(map[int]string)
+	for _, u := range entries {
+		if _, ok := users[u.Uid]; !ok {
+			users[u.Uid] = u.Name
+		}
+	}
+	return users, nil
+}
+
+func getProcessInfo(pid uint32) (*v1.ProcessInfo, error) {
+	p, err := procfs.NewProc(int(pid))
+	if err != nil {
+		return nil, err
+	}
+	args, err := p.CmdLine()
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 {
+		// kernel threads and zombies have no command line
+		comm, err := p.Comm()
+		if err != nil {
+			return nil, err
+		}
+		args = []string{"[" + comm + "]"}
+	}
+	stat, err := p.NewStat()
+	if err != nil {
+		return nil, err
+	}
+	fi, err := os.Stat(filepath.Join(procfs.DefaultMountPoint, strconv.Itoa(int(pid))))
+	if err != nil {
+		return nil, err
+	}
+	info := &v1.ProcessInfo{
+		Pid:  pid,
+		Args: args,
+		Rss:  uint64(stat.ResidentMemory()),
+	}
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+		info.Uid, info.Gid = st.Uid, st.Gid
+	}
+	start, err := stat.StartTime()
+	if err != nil {
+		return nil, err
+	}
+	if elapsed := float64(time.Now().Unix()) - start; elapsed > 0 {
+		info.Cpu = stat.CPUTime() / elapsed * 100
+	}
+	return info, nil
+}
+
+// kill sends the signal from the request to the task, all of its processes,
+// or a single process in the container
+func kill(ctx context.Context, task containerd.Task, req *v1.KillRequest) error {
+	signal := unix.SIGTERM
+	switch {
+	case req.SignalName != "":
+		s, err := parseSignal(req.SignalName)
+		if err != nil {
+			return err
+		}
+		signal = s
+	case req.Signal != 0:
+		signal = syscall.Signal(req.Signal)
+	}
+	if req.Pid == 0 {
+		var opts []containerd.KillOpts
+		if req.All {
+			opts = append(opts, containerd.WithKillAll)
+		}
+		return task.Kill(ctx, signal, opts...)
+	}
+	if req.All {
+		return errors.New("all and pid cannot be specified together")
+	}
+	pids, err := task.Pids(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range pids {
+		if p.Pid == req.Pid {
+			return unix.Kill(int(p.Pid), signal)
+		}
+	}
+	return errors.Errorf("pid %d not found in container %s", req.Pid, req.ID)
+}
+
+// parseSignal parses a signal name with or without the SIG prefix or a signal number
+func parseSignal(s string) (syscall.Signal, error) {
+	if _, err := strconv.Atoi(s); err != nil && !strings.HasPrefix(strings.ToUpper(s), "SIG") {
+		s = "SIG" + s
+	}
+	return containerd.ParseSignal(s)
+}
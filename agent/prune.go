@@ -0,0 +1,100 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containerd/containerd"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/opts"
+	"github.com/stellarproject/terraos/pkg/flux"
+)
+
+func (a *Agent) Prune(ctx context.Context, req *v1.PruneRequest) (*v1.PruneResponse, error) {
+	ctx = relayContext(ctx)
+	policy := a.config.retentionPolicy()
+	if req.Keep > 0 || req.MaxAge > 0 {
+		policy = flux.RetentionPolicy{
+			Keep:   int(req.Keep),
+			MaxAge: req.MaxAge,
+		}
+	}
+	var containers []containerd.Container
+	if req.ID != "" {
+		container, err := a.client.LoadContainer(ctx, req.ID)
+		if err != nil {
+			return nil, err
+		}
+		containers = append(containers, container)
+	} else {
+		all, err := a.client.Containers(ctx, fmt.Sprintf("labels.%q", StatusLabel))
+		if err != nil {
+			return nil, err
+		}
+		containers = all
+	}
+	a.supervisorMu.Lock()
+	defer a.supervisorMu.Unlock()
+
+	var resp v1.PruneResponse
+	for _, c := range containers {
+		revisions, err := a.prune(ctx, c, policy)
+		for _, r := range revisions {
+			resp.Snapshots = append(resp.Snapshots, &v1.Snapshot{
+				ID:      r.Key,
+				Created: r.Timestamp,
+				FsSize:  r.Size,
+			})
+			resp.Reclaimed += r.Size
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &resp, nil
+}
+
+func (a *Agent) prune(ctx context.Context, container containerd.Container, policy flux.RetentionPolicy) ([]*flux.Revision, error) {
+	info, err := container.Info(ctx)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := flux.Prune(ctx, a.client, info, policy)
+	if len(revisions) > 0 {
+		var keys []string
+		for _, r := range revisions {
+			keys = append(keys, r.Key)
+		}
+		if uerr := container.Update(ctx, opts.WithoutRevisionConfigs(keys...)); uerr != nil && err == nil {
+			err = uerr
+		}
+	}
+	return revisions, err
+}
@@ -0,0 +1,129 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/containers"
+	"github.com/pkg/errors"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+)
+
+const (
+	RestartAlways    = "always"
+	RestartOnFailure = "on-failure"
+	RestartNever     = "never"
+
+	restartDelay    = 5 * time.Second
+	maxRestartDelay = 5 * time.Minute
+)
+
+func validateRestartPolicy(p *v1.RestartPolicy) error {
+	if p == nil {
+		return nil
+	}
+	switch p.Policy {
+	case "", RestartAlways, RestartOnFailure, RestartNever:
+		return nil
+	}
+	return errors.Errorf("invalid restart policy %q", p.Policy)
+}
+
+// shouldRestart returns true if the policy allows an exited container to be started again
+func shouldRestart(p *v1.RestartPolicy, exitCode uint32, restarts int) bool {
+	if p == nil {
+		return true
+	}
+	switch p.Policy {
+	case RestartNever:
+		return false
+	case RestartOnFailure:
+		if exitCode == 0 {
+			return false
+		}
+		return p.MaxRetries == 0 || uint32(restarts) < p.MaxRetries
+	}
+	return true
+}
+
+// restartBackoff returns the time to wait after an exit before the next restart,
+// doubling with every restart up to maxRestartDelay
+func restartBackoff(restarts int) time.Duration {
+	d := restartDelay
+	for i := 0; i < restarts; i++ {
+		if d *= 2; d >= maxRestartDelay {
+			return maxRestartDelay
+		}
+	}
+	return d
+}
+
+func withRestarts(n int) containerd.UpdateContainerOpts {
+	return func(_ context.Context, _ *containerd.Client, c *containers.Container) error {
+		ensureLabels(c)
+		c.Labels[RestartCountLabel] = strconv.Itoa(n)
+		return nil
+	}
+}
+
+func withExitStatus(status containerd.Status) containerd.UpdateContainerOpts {
+	return func(_ context.Context, _ *containerd.Client, c *containers.Container) error {
+		ensureLabels(c)
+		c.Labels[ExitCodeLabel] = strconv.FormatUint(uint64(status.ExitStatus), 10)
+		c.Labels[ExitTimeLabel] = status.ExitTime.Format(time.RFC3339Nano)
+		return nil
+	}
+}
+
+func withStartTime(t time.Time) containerd.UpdateContainerOpts {
+	return func(_ context.Context, _ *containerd.Client, c *containers.Container) error {
+		ensureLabels(c)
+		c.Labels[StartTimeLabel] = t.Format(time.RFC3339Nano)
+		return nil
+	}
+}
+
+func getRestarts(labels map[string]string) int {
+	n, _ := strconv.Atoi(labels[RestartCountLabel])
+	return n
+}
+
+func getExitStatus(labels map[string]string) (uint32, time.Time) {
+	code, _ := strconv.ParseUint(labels[ExitCodeLabel], 10, 32)
+	exitedAt, _ := time.Parse(time.RFC3339Nano, labels[ExitTimeLabel])
+	return uint32(code), exitedAt
+}
+
+func getStartTime(labels map[string]string) time.Time {
+	startedAt, _ := time.Parse(time.RFC3339Nano, labels[StartTimeLabel])
+	return startedAt
+}
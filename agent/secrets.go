@@ -0,0 +1,185 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/opts"
+	"golang.org/x/sys/unix"
+)
+
+const defaultSecretMode = 0400
+
+func (a *Agent) CreateSecret(ctx context.Context, req *v1.CreateSecretRequest) (*types.Empty, error) {
+	if _, err := a.secrets.Create(req.Name, req.Data); err != nil {
+		return nil, err
+	}
+	return empty, nil
+}
+
+func (a *Agent) DeleteSecret(ctx context.Context, req *v1.DeleteSecretRequest) (*types.Empty, error) {
+	ctx = relayContext(ctx)
+	users, err := a.secretUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ids := users[req.Name]; len(ids) > 0 {
+		return nil, errors.Wrapf(errdefs.ErrFailedPrecondition, "secret %s is used by %s", req.Name, strings.Join(ids, ", "))
+	}
+	if err := a.secrets.Remove(req.Name); err != nil {
+		return nil, err
+	}
+	return empty, nil
+}
+
+func (a *Agent) ListSecrets(ctx context.Context, req *v1.ListSecretsRequest) (*v1.ListSecretsResponse, error) {
+	ctx = relayContext(ctx)
+	secrets, err := a.secrets.List()
+	if err != nil {
+		return nil, err
+	}
+	users, err := a.secretUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var resp v1.ListSecretsResponse
+	for _, s := range secrets {
+		resp.Secrets = append(resp.Secrets, &v1.Secret{
+			Name:       s.Name,
+			Created:    s.Created,
+			Containers: users[s.Name],
+		})
+	}
+	return &resp, nil
+}
+
+// secretUsers returns the ids of the containers using each secret
+func (a *Agent) secretUsers(ctx context.Context) (map[string][]string, error) {
+	containers, err := a.client.Containers(ctx, fmt.Sprintf("labels.%q", StatusLabel))
+	if err != nil {
+		return nil, err
+	}
+	users := make(map[string][]string)
+	for _, c := range containers {
+		config, err := opts.GetConfig(ctx, c)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get config %s", c.ID())
+		}
+		for _, s := range config.Secrets {
+			users[s.ID] = append(users[s.ID], c.ID())
+		}
+	}
+	return users, nil
+}
+
+// validateSecrets ensures that the secrets mounted by the container exist
+func (a *Agent) validateSecrets(c *v1.Container) error {
+	for _, s := range c.Secrets {
+		if _, err := a.secrets.Get(s.ID); err != nil {
+			return err
+		}
+		if s.Path != "" && !path.IsAbs(s.Path) {
+			return errors.Errorf("secret path %s is not absolute", s.Path)
+		}
+		if s.Mode&^0777 != 0 {
+			return errors.Errorf("invalid mode %o for secret %s", s.Mode, s.ID)
+		}
+	}
+	return nil
+}
+
+// mountSecrets writes the container's secrets to a new tmpfs owned by the container's user
+func (a *Agent) mountSecrets(ctx context.Context, container containerd.Container, config *v1.Container) error {
+	dir := a.config.Paths(container.ID()).SecretsPath()
+	if err := unmountSecrets(dir); err != nil {
+		return err
+	}
+	if len(config.Secrets) == 0 {
+		return nil
+	}
+	spec, err := container.Spec(ctx)
+	if err != nil {
+		return err
+	}
+	uid, gid := opts.HostUser(getUserNamespace(config), spec.Process.User.UID, spec.Process.User.GID)
+	if err := os.MkdirAll(dir, 0711); err != nil {
+		return err
+	}
+	if err := unix.Mount("tmpfs", dir, "tmpfs", unix.MS_NOSUID|unix.MS_NODEV|unix.MS_NOEXEC, "mode=0711"); err != nil {
+		return errors.Wrapf(err, "mount tmpfs %s", dir)
+	}
+	for _, s := range config.Secrets {
+		if err := a.writeSecret(filepath.Join(dir, s.ID), s, int(uid), int(gid)); err != nil {
+			unmountSecrets(dir)
+			return errors.Wrapf(err, "write secret %s", s.ID)
+		}
+	}
+	return nil
+}
+
+func (a *Agent) writeSecret(path string, s *v1.SecretFile, uid, gid int) error {
+	data, err := a.secrets.Data(s.ID)
+	if err != nil {
+		return err
+	}
+	mode := os.FileMode(s.Mode)
+	if mode == 0 {
+		mode = defaultSecretMode
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, mode)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		return err
+	}
+	if err := f.Chmod(mode); err != nil {
+		return err
+	}
+	return f.Chown(uid, gid)
+}
+
+// unmountSecrets removes the container's secrets tmpfs
+func unmountSecrets(dir string) error {
+	if err := unix.Unmount(dir, unix.MNT_DETACH); err != nil && err != unix.EINVAL && err != unix.ENOENT {
+		return errors.Wrapf(err, "unmount %s", dir)
+	}
+	return os.RemoveAll(dir)
+}
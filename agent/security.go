@@ -0,0 +1,126 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/opts"
+)
+
+const apparmorProfiles = "/sys/kernel/security/apparmor/profiles"
+
+func validateSecurity(c *v1.Container) error {
+	s := c.Security
+	if s == nil {
+		return nil
+	}
+	if s.Privileged && (s.SeccompProfile != "" || s.ApparmorProfile != "") {
+		return errors.New("security profiles cannot be set on privileged containers")
+	}
+	if s.SeccompProfile != "" {
+		if s.SeccompUnconfined {
+			return errors.New("seccomp profile cannot be set on an unconfined container")
+		}
+		if _, err := opts.ParseSeccompProfile(s.SeccompProfile); err != nil {
+			return err
+		}
+	}
+	if s.ApparmorProfile != "" {
+		if s.ApparmorUnconfined {
+			return errors.New("apparmor profile cannot be set on an unconfined container")
+		}
+		loaded, err := apparmorLoaded(s.ApparmorProfile)
+		if err != nil {
+			return errors.Wrap(err, "check apparmor profile")
+		}
+		if !loaded {
+			return errors.Errorf("apparmor profile %q is not loaded", s.ApparmorProfile)
+		}
+	}
+	for _, name := range append(s.Capabilities, s.DropCapabilities...) {
+		if name != opts.AllCapabilities && !strings.HasPrefix(name, "CAP_") {
+			return errors.Errorf("invalid capability %q", name)
+		}
+	}
+	for _, name := range s.DropCapabilities {
+		if contains(s.Capabilities, name) {
+			return errors.Errorf("capability %s is both added and dropped", name)
+		}
+	}
+	return nil
+}
+
+// loadApparmorProfiles loads or replaces the profiles in the directory
+func loadApparmorProfiles(dir string) error {
+	if dir == "" {
+		return nil
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, f.Name())
+		if out, err := exec.Command("apparmor_parser", "-Kr", path).CombinedOutput(); err != nil {
+			return errors.Wrapf(err, "load %s: %s", path, out)
+		}
+		logrus.WithField("profile", path).Info("loaded apparmor profile")
+	}
+	return nil
+}
+
+func apparmorLoaded(name string) (bool, error) {
+	f, err := os.Open(apparmorProfiles)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		// lines are in the format "name (mode)"
+		if strings.HasPrefix(s.Text(), name+" ") {
+			return true, nil
+		}
+	}
+	return false, s.Err()
+}
@@ -29,10 +29,13 @@ package agent
 
 import (
 	"context"
+	"time"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/contrib/apparmor"
 	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
 )
 
 type stateChange interface {
@@ -45,7 +48,7 @@ type stopDiff struct {
 }
 
 func (s *stopDiff) apply(ctx context.Context) error {
-	return s.a.stop(ctx, s.container)
+	return s.a.stop(ctx, s.container, 0)
 }
 
 type startDiff struct {
@@ -57,6 +60,55 @@ func (s *startDiff) apply(ctx context.Context) error {
 	return s.a.start(ctx, s.container)
 }
 
+// restartDiff handles a container that exited while its desired status is running
+type restartDiff struct {
+	a         *Agent
+	container containerd.Container
+	labels    map[string]string
+	status    containerd.Status
+	restarts  int
+	restart   bool
+}
+
+func (s *restartDiff) apply(ctx context.Context) error {
+	if _, exitedAt := getExitStatus(s.labels); !exitedAt.Equal(s.status.ExitTime) {
+		if err := s.container.Update(ctx, withExitStatus(s.status)); err != nil {
+			return err
+		}
+	}
+	logger := logrus.WithField("id", s.container.ID()).WithField("exit", s.status.ExitStatus)
+	if !s.restart {
+		logger.Info("restart policy does not allow restart")
+		return s.a.stop(ctx, s.container, 0)
+	}
+	if delay := restartBackoff(s.restarts); time.Since(s.status.ExitTime) < delay {
+		logger.WithField("delay", delay).Debug("backing off restart")
+		return nil
+	}
+	logger.WithField("restarts", s.restarts+1).Info("restarting container")
+	if err := s.container.Update(ctx, withRestarts(s.restarts+1)); err != nil {
+		return err
+	}
+	return s.a.start(ctx, s.container)
+}
+
+// unhealthyDiff kills a task that failed its health checks so that
+// it is handled by the restart policy like any other exit
+type unhealthyDiff struct {
+	a         *Agent
+	container containerd.Container
+}
+
+func (s *unhealthyDiff) apply(ctx context.Context) error {
+	logrus.WithField("id", s.container.ID()).Warn("killing unhealthy container")
+	s.a.health.reset(s.container.ID())
+	task, err := s.container.Task(ctx, nil)
+	if err != nil {
+		return err
+	}
+	return task.Kill(ctx, unix.SIGKILL)
+}
+
 func sameDiff() stateChange {
 	return &nullDiff{}
 }
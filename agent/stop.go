@@ -0,0 +1,102 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"context"
+	"syscall"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/opts"
+)
+
+const (
+	defaultStopSignal  = "SIGTERM"
+	defaultStopTimeout = 10 * time.Second
+)
+
+func validateStopSignal(c *v1.Container) error {
+	if c.StopSignal == "" {
+		return nil
+	}
+	if _, err := parseSignal(c.StopSignal); err != nil {
+		return errors.Wrapf(err, "invalid stop signal %q", c.StopSignal)
+	}
+	return nil
+}
+
+// getStopSignal returns the signal and grace period used to stop the container
+// the container's stop signal overrides the one configured in the image
+func (a *Agent) getStopSignal(ctx context.Context, container containerd.Container) (syscall.Signal, time.Duration, error) {
+	config, err := opts.GetConfig(ctx, container)
+	if err != nil {
+		return 0, 0, err
+	}
+	timeout := config.StopTimeout
+	if timeout <= 0 {
+		timeout = defaultStopTimeout
+	}
+	signal := config.StopSignal
+	if signal == "" {
+		signal = defaultStopSignal
+		image, err := a.client.GetImage(ctx, config.Image)
+		if err == nil {
+			if signal, err = containerd.GetOCIStopSignal(ctx, image, defaultStopSignal); err != nil {
+				return 0, 0, err
+			}
+		} else {
+			logrus.WithError(err).WithField("id", container.ID()).Debug("get image for stop signal")
+		}
+	}
+	s, err := parseSignal(signal)
+	if err != nil {
+		return 0, 0, err
+	}
+	return s, timeout, nil
+}
+
+// waitOrKill waits for the task to exit after it was signaled, killing it
+// if it does not exit within the timeout
+func waitOrKill(ctx context.Context, task containerd.Task, wait <-chan containerd.ExitStatus, timeout time.Duration) error {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		logrus.WithField("id", task.ID()).WithField("timeout", timeout).Warn("task did not exit in time, killing")
+		if err := task.Kill(ctx, syscall.SIGKILL); err != nil {
+			return err
+		}
+		<-wait
+	case <-wait:
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"net"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/opts"
+)
+
+// namespacedSysctls can be set per container as they are isolated by the ipc namespace
+var namespacedSysctls = map[string]struct{}{
+	"kernel.msgmax":          {},
+	"kernel.msgmnb":          {},
+	"kernel.msgmni":          {},
+	"kernel.sem":             {},
+	"kernel.shmall":          {},
+	"kernel.shmmax":          {},
+	"kernel.shmmni":          {},
+	"kernel.shm_rmid_forced": {},
+}
+
+func validateSysctls(c *v1.Container) error {
+	for k := range c.Sysctls {
+		if _, ok := namespacedSysctls[k]; ok || strings.HasPrefix(k, "fs.mqueue.") {
+			continue
+		}
+		if strings.HasPrefix(k, "net.") {
+			if hostNetwork(c) {
+				return errors.Errorf("sysctl %s cannot be set on the host network", k)
+			}
+			continue
+		}
+		return errors.Errorf("sysctl %s is not namespaced", k)
+	}
+	return nil
+}
+
+func validateRlimits(c *v1.Container) error {
+	if c.Process == nil {
+		return nil
+	}
+	for _, r := range c.Process.Rlimits {
+		if _, err := opts.RlimitType(r.Type); err != nil {
+			return err
+		}
+		if r.Soft > r.Hard {
+			return errors.Errorf("soft limit of %s is larger than the hard limit", r.Type)
+		}
+	}
+	return nil
+}
+
+func validateExtraHosts(c *v1.Container) error {
+	if len(c.ExtraHosts) == 0 {
+		return nil
+	}
+	if hostNetwork(c) {
+		return errors.New("extra hosts cannot be set on the host network")
+	}
+	for _, h := range c.ExtraHosts {
+		if net.ParseIP(h.IP) == nil {
+			return errors.Errorf("invalid extra host ip %q", h.IP)
+		}
+		if len(h.Names) == 0 {
+			return errors.Errorf("no names for extra host %s", h.IP)
+		}
+	}
+	return nil
+}
+
+func hostNetwork(c *v1.Container) bool {
+	return len(c.Networks) == 1 && c.Networks[0].TypeUrl == proto.MessageName(&v1.HostNetwork{})
+}
@@ -0,0 +1,66 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"github.com/containerd/containerd"
+	"github.com/pkg/errors"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/pkg/flux"
+)
+
+func validateUpgrade(c *v1.Container) error {
+	switch c.Upgrade {
+	case "", flux.UpgradeCopy, flux.UpgradeDrop:
+	case flux.UpgradeBtrfs:
+		if getSnapshotter(c) != "btrfs" {
+			return errors.Errorf("btrfs upgrade requires the btrfs snapshotter")
+		}
+	default:
+		return errors.Errorf("invalid upgrade mode %q", c.Upgrade)
+	}
+	return nil
+}
+
+func getSnapshotter(c *v1.Container) string {
+	if c.Snapshotter != "" {
+		return c.Snapshotter
+	}
+	return containerd.DefaultSnapshotter
+}
+
+// upgradeOpt returns the flux upgrade for the container's upgrade mode
+func (a *Agent) upgradeOpt(c *v1.Container, image containerd.Image) containerd.UpdateContainerOpts {
+	switch c.Upgrade {
+	case flux.UpgradeDrop:
+		return flux.WithDropUpgrade(image)
+	case flux.UpgradeBtrfs:
+		return flux.WithBtrfsUpgrade(image, a.config.BtrfsRoot)
+	}
+	return flux.WithUpgrade(image)
+}
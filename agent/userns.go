@@ -0,0 +1,83 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"github.com/containerd/containerd"
+	"github.com/pkg/errors"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/pkg/flux"
+)
+
+func validateUserNamespace(c *v1.Container) error {
+	userns := getUserNamespace(c)
+	if userns == nil {
+		return nil
+	}
+	if c.Security.Privileged {
+		return errors.New("privileged containers cannot run in a user namespace")
+	}
+	if userns.Uid == 0 || userns.Gid == 0 {
+		return errors.New("user namespace must map root to an unprivileged host uid and gid")
+	}
+	return nil
+}
+
+func getUserNamespace(c *v1.Container) *v1.UserNamespace {
+	if c.Security == nil {
+		return nil
+	}
+	return c.Security.Userns
+}
+
+// snapshotOpts returns the opts to create the container's first revision
+func snapshotOpts(c *v1.Container, image containerd.Image) []containerd.NewContainerOpts {
+	o := []containerd.NewContainerOpts{
+		containerd.WithSnapshotter(getSnapshotter(c)),
+	}
+	if userns := getUserNamespace(c); userns != nil {
+		o = append(o, flux.WithRemap(userns.Uid, userns.Gid))
+	}
+	return append(o, flux.WithNewSnapshot(image))
+}
+
+// checkUserNamespace ensures that the user namespace mapping is not changed on update
+// as the container's revisions are owned by the existing mapping
+func checkUserNamespace(current, next *v1.Container) error {
+	var (
+		from = getUserNamespace(current)
+		to   = getUserNamespace(next)
+	)
+	if from == nil && to == nil {
+		return nil
+	}
+	if from == nil || to == nil || from.Uid != to.Uid || from.Gid != to.Gid {
+		return errors.Errorf("user namespace of %s cannot be changed, recreate the container", next.ID)
+	}
+	return nil
+}
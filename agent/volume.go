@@ -0,0 +1,225 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/errdefs"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/opts"
+	"github.com/stellarproject/terraos/pkg/volume"
+)
+
+func (a *Agent) CreateVolume(ctx context.Context, req *v1.CreateVolumeRequest) (*v1.CreateVolumeResponse, error) {
+	v, err := a.volumes.Create(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateVolumeResponse{
+		Volume: toVolume(v),
+	}, nil
+}
+
+func (a *Agent) DeleteVolume(ctx context.Context, req *v1.DeleteVolumeRequest) (*types.Empty, error) {
+	ctx = relayContext(ctx)
+	users, err := a.volumeUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ids := users[req.Name]; len(ids) > 0 {
+		return nil, errors.Wrapf(errdefs.ErrFailedPrecondition, "volume %s is used by %s", req.Name, strings.Join(ids, ", "))
+	}
+	if err := a.volumes.Remove(req.Name); err != nil {
+		return nil, err
+	}
+	return empty, nil
+}
+
+func (a *Agent) GetVolume(ctx context.Context, req *v1.GetVolumeRequest) (*v1.GetVolumeResponse, error) {
+	ctx = relayContext(ctx)
+	v, err := a.volumes.Get(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	users, err := a.volumeUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info, err := a.volumeInfo(ctx, v, users)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetVolumeResponse{
+		Volume: info,
+	}, nil
+}
+
+func (a *Agent) ListVolumes(ctx context.Context, req *v1.ListVolumesRequest) (*v1.ListVolumesResponse, error) {
+	ctx = relayContext(ctx)
+	volumes, err := a.volumes.List()
+	if err != nil {
+		return nil, err
+	}
+	users, err := a.volumeUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var resp v1.ListVolumesResponse
+	for _, v := range volumes {
+		info, err := a.volumeInfo(ctx, v, users)
+		if err != nil {
+			return nil, err
+		}
+		resp.Volumes = append(resp.Volumes, info)
+	}
+	return &resp, nil
+}
+
+func (a *Agent) volumeInfo(ctx context.Context, v *volume.Volume, users map[string][]string) (*v1.Volume, error) {
+	size, err := a.volumes.Usage(ctx, v)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get usage for volume %s", v.Name)
+	}
+	info := toVolume(v)
+	info.FsSize = size
+	info.Containers = users[v.Name]
+	return info, nil
+}
+
+// volumeUsers returns the ids of the containers using each volume
+func (a *Agent) volumeUsers(ctx context.Context) (map[string][]string, error) {
+	containers, err := a.client.Containers(ctx, fmt.Sprintf("labels.%q", StatusLabel))
+	if err != nil {
+		return nil, err
+	}
+	users := make(map[string][]string)
+	for _, c := range containers {
+		config, err := opts.GetConfig(ctx, c)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get config %s", c.ID())
+		}
+		for _, m := range config.Mounts {
+			if m.Type == "volume" {
+				users[m.Source] = append(users[m.Source], c.ID())
+			}
+		}
+	}
+	return users, nil
+}
+
+// validateVolumes ensures the volume names are valid, missing volumes are created on start
+func (a *Agent) validateVolumes(c *v1.Container) error {
+	for _, m := range c.Mounts {
+		if m.Type != "volume" {
+			continue
+		}
+		if _, err := a.volumes.Get(m.Source); err != nil && !errdefs.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureVolumes creates the volumes mounted by the container that do not exist and
+// claims unclaimed volumes for the spec user so that an image's user can write to them
+func (a *Agent) ensureVolumes(ctx context.Context, container containerd.Container, c *v1.Container) error {
+	if !hasVolumes(c) {
+		return nil
+	}
+	spec, err := container.Spec(ctx)
+	if err != nil {
+		return err
+	}
+	uid, gid := opts.HostUser(getUserNamespace(c), spec.Process.User.UID, spec.Process.User.GID)
+	for _, m := range c.Mounts {
+		if m.Type != "volume" {
+			continue
+		}
+		v, err := a.volumes.Get(m.Source)
+		if err != nil {
+			if !errdefs.IsNotFound(err) {
+				return err
+			}
+			if v, err = a.volumes.Create(m.Source); err != nil {
+				return err
+			}
+		}
+		if v.Claimed {
+			continue
+		}
+		if err := a.volumes.Claim(v, int(uid), int(gid)); err != nil {
+			return errors.Wrapf(err, "claim volume %s", v.Name)
+		}
+	}
+	return nil
+}
+
+func hasVolumes(c *v1.Container) bool {
+	for _, m := range c.Mounts {
+		if m.Type == "volume" {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Agent) getVolumeSizes(ctx context.Context, c *v1.Container) (size int64, _ error) {
+	for _, m := range c.Mounts {
+		if m.Type != "volume" {
+			continue
+		}
+		v, err := a.volumes.Get(m.Source)
+		if err != nil {
+			if errdefs.IsNotFound(err) {
+				continue
+			}
+			return size, err
+		}
+		usage, err := a.volumes.Usage(ctx, v)
+		if err != nil {
+			return size, err
+		}
+		size += usage
+	}
+	return size, nil
+}
+
+func toVolume(v *volume.Volume) *v1.Volume {
+	return &v1.Volume{
+		Name:    v.Name,
+		Driver:  v.Driver,
+		Path:    v.Path,
+		Created: v.Created,
+	}
+}
@@ -0,0 +1,68 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package main
+
+import (
+	"fmt"
+
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/urfave/cli"
+)
+
+var commitCommand = cli.Command{
+	Name:  "commit",
+	Usage: "commit a container's filesystem and config to a new image",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "push",
+			Usage: "push the image after it is committed",
+		},
+	},
+	Action: func(clix *cli.Context) error {
+		var (
+			id  = clix.Args().First()
+			ref = clix.Args().Get(1)
+			ctx = Context()
+		)
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		resp, err := agent.Commit(ctx, &v1.CommitRequest{
+			ID:   id,
+			Ref:  ref,
+			Push: clix.Bool("push"),
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Println(resp.Digest)
+		return nil
+	},
+}
@@ -29,6 +29,7 @@ package main
 
 import (
 	"os"
+	"time"
 
 	"github.com/BurntSushi/toml"
 	v1 "github.com/stellarproject/terraos/config/v1"
@@ -45,10 +46,29 @@ var configCommand = cli.Command{
 			ID:            "redis-01",
 			Image:         "docker.io/library/redis:alpine",
 			Resources: &v1.Resources{
-				CPU:    1.5,
-				Memory: 1024,
-				Score:  -1,
-				NoFile: 1024,
+				CPU:               1.5,
+				Memory:            1024,
+				Score:             -1,
+				NoFile:            1024,
+				CPUSetCPUs:        "0-3",
+				CPUShares:         1024,
+				MemoryReservation: 512,
+				MemorySwap:        2048,
+				Pids:              512,
+				BlkioWeight:       500,
+				DeviceThrottles: []v1.DeviceThrottle{
+					{
+						Device:   "/dev/sda",
+						ReadBps:  100 * 1024 * 1024,
+						WriteBps: 50 * 1024 * 1024,
+					},
+				},
+				Hugepages: []v1.Hugepage{
+					{
+						PageSize: "2MB",
+						Limit:    256,
+					},
+				},
 			},
 			GPUs: &v1.GPUs{
 				Devices: []int64{
@@ -68,6 +88,17 @@ var configCommand = cli.Command{
 						"rw",
 					},
 				},
+				{
+					Type:        "volume",
+					Source:      "data",
+					Destination: "/var/lib/data",
+				},
+			},
+			Devices: []v1.Device{
+				{
+					HostPath:    "/dev/fuse",
+					Permissions: "rwm",
+				},
 			},
 			Env: []string{
 				"VAR=1",
@@ -78,10 +109,16 @@ var configCommand = cli.Command{
 			UID:      &uid,
 			GID:      &uid,
 			Services: []string{"redis.io"},
+			Secrets: []v1.SecretFile{
+				{
+					ID: "redis-password",
+				},
+			},
 			Configs: []v1.ConfigFile{
 				{
-					ID:   "config",
-					Path: "/redis.config",
+					ID:     "config",
+					Path:   "/redis.config",
+					Signal: "SIGHUP",
 				},
 			},
 			Readonly: true,
@@ -90,6 +127,36 @@ var configCommand = cli.Command{
 			},
 			Privileged: true,
 			Pty:        true,
+			Restart: &v1.Restart{
+				Policy:     "on-failure",
+				MaxRetries: 5,
+			},
+			Health: &v1.HealthCheck{
+				Type:     "exec",
+				Args:     []string{"redis-cli", "ping"},
+				Interval: v1.Duration{Duration: 30 * time.Second},
+				Timeout:  v1.Duration{Duration: 5 * time.Second},
+				Retries:  3,
+			},
+			StopSignal:  "SIGTERM",
+			StopTimeout: v1.Duration{Duration: 30 * time.Second},
+			Cwd:         "/data",
+			Rlimits: []v1.Rlimit{
+				{
+					Type: "nproc",
+					Soft: 1024,
+					Hard: 2048,
+				},
+			},
+			Sysctls: map[string]string{
+				"net.core.somaxconn": "1024",
+			},
+			ExtraHosts: []v1.Host{
+				{
+					IP:    "10.0.0.5",
+					Names: []string{"db", "db.local"},
+				},
+			},
 			Networks: []*v1.Network{
 				{
 					Type: "macvlan",
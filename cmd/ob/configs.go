@@ -0,0 +1,206 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	units "github.com/docker/go-units"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/urfave/cli"
+)
+
+var configsCommand = cli.Command{
+	Name:  "configs",
+	Usage: "manage configs mounted into containers",
+	Subcommands: []cli.Command{
+		configsCreateCommand,
+		configsDeleteCommand,
+		configsGetCommand,
+		configsListCommand,
+		configsUpdateCommand,
+	},
+}
+
+var configsCreateCommand = cli.Command{
+	Name:      "create",
+	Usage:     "create a config from a file",
+	ArgsUsage: "NAME FILE",
+	Action: func(clix *cli.Context) error {
+		var (
+			name = clix.Args().First()
+			ctx  = Context()
+		)
+		data, err := readConfigData(clix.Args().Get(1))
+		if err != nil {
+			return err
+		}
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		resp, err := agent.CreateConfig(ctx, &v1.CreateConfigRequest{
+			Name: name,
+			Data: data,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Println(resp.Config.Digest)
+		return nil
+	},
+}
+
+var configsDeleteCommand = cli.Command{
+	Name:  "delete",
+	Usage: "delete configs",
+	Action: func(clix *cli.Context) error {
+		ctx := Context()
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		for _, name := range clix.Args() {
+			if _, err := agent.DeleteConfig(ctx, &v1.DeleteConfigRequest{
+				Name: name,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+var configsGetCommand = cli.Command{
+	Name:  "get",
+	Usage: "print the data of a config",
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "version,v",
+			Usage: "version of the config, defaults to the current version",
+		},
+	},
+	Action: func(clix *cli.Context) error {
+		var (
+			name = clix.Args().First()
+			ctx  = Context()
+		)
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		resp, err := agent.GetConfig(ctx, &v1.GetConfigRequest{
+			Name:    name,
+			Version: clix.Uint64("version"),
+		})
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(resp.Data)
+		return err
+	},
+}
+
+var configsListCommand = cli.Command{
+	Name:  "list",
+	Usage: "list configs",
+	Action: func(clix *cli.Context) error {
+		ctx := Context()
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		resp, err := agent.ListConfigs(ctx, &v1.ListConfigsRequest{})
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
+		const tfmt = "%s\t%d\t%s\t%s\t%s\n"
+		fmt.Fprint(w, "NAME\tVERSION\tUPDATED\tSIZE\tCONTAINERS\n")
+		for _, c := range resp.Configs {
+			fmt.Fprintf(w, tfmt,
+				c.Name,
+				c.Version,
+				c.Updated.Format(time.RFC3339),
+				units.HumanSize(float64(c.FsSize)),
+				orDash(strings.Join(c.Containers, ",")),
+			)
+		}
+		return w.Flush()
+	},
+}
+
+var configsUpdateCommand = cli.Command{
+	Name:      "update",
+	Usage:     "update a config from a file and reload the containers using it",
+	ArgsUsage: "NAME FILE",
+	Action: func(clix *cli.Context) error {
+		var (
+			name = clix.Args().First()
+			ctx  = Context()
+		)
+		data, err := readConfigData(clix.Args().Get(1))
+		if err != nil {
+			return err
+		}
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		resp, err := agent.UpdateConfig(ctx, &v1.UpdateConfigRequest{
+			Name: name,
+			Data: data,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Printf("version %d\n", resp.Config.Version)
+		for _, id := range resp.Reloaded {
+			fmt.Printf("reloaded %s\n", id)
+		}
+		return nil
+	},
+}
+
+// readConfigData reads the file or stdin for -, it is used for configs and secrets
+func readConfigData(path string) ([]byte, error) {
+	if path == "-" {
+		return ioutil.ReadAll(os.Stdin)
+	}
+	return ioutil.ReadFile(path)
+}
@@ -0,0 +1,86 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/urfave/cli"
+)
+
+var eventsCommand = cli.Command{
+	Name:  "events",
+	Usage: "stream container events",
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "id",
+			Usage: "only show events for the container id",
+			Value: &cli.StringSlice{},
+		},
+		cli.StringSliceFlag{
+			Name:  "type",
+			Usage: "only show events of the type",
+			Value: &cli.StringSlice{},
+		},
+	},
+	Action: func(clix *cli.Context) error {
+		ctx := cancelContext()
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		stream, err := agent.Events(ctx, &v1.EventsRequest{
+			IDs:   clix.StringSlice("id"),
+			Types: clix.StringSlice("type"),
+		})
+		if err != nil {
+			return err
+		}
+		for {
+			e, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF || ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			line := fmt.Sprintf("%s %s %s", e.Timestamp.Format(time.RFC3339Nano), e.ID, e.Type)
+			if e.Type == "exit" {
+				line += fmt.Sprintf(" code=%d", e.ExitCode)
+			}
+			if e.Message != "" {
+				line += " " + e.Message
+			}
+			fmt.Println(line)
+		}
+	},
+}
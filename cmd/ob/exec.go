@@ -28,117 +28,139 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"io"
 	"os"
+	"sync"
 
 	"github.com/containerd/console"
-	"github.com/containerd/containerd"
-	"github.com/containerd/containerd/cio"
 	"github.com/containerd/containerd/cmd/ctr/commands/tasks"
-	"github.com/containerd/containerd/defaults"
-	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
 	"github.com/urfave/cli"
 )
 
 var execCommand = cli.Command{
 	Name:  "exec",
 	Usage: "exec a process inside a container",
+	Flags: []cli.Flag{
+		cli.BoolTFlag{
+			Name:  "tty,t",
+			Usage: "allocate a tty for the process",
+		},
+		cli.StringSliceFlag{
+			Name:  "env,e",
+			Usage: "set additional environment variables",
+			Value: &cli.StringSlice{},
+		},
+	},
 	Action: func(clix *cli.Context) error {
 		var (
 			id   = clix.Args().First()
 			args = clix.Args().Tail()
+			tty  = clix.BoolT("tty")
 			ctx  = Context()
 		)
 		if id == "" {
 			return errors.New("id must not be empty")
 		}
-		client, err := containerd.New(defaults.DefaultAddress)
+		agent, err := Agent(clix)
 		if err != nil {
 			return err
 		}
-		defer client.Close()
+		defer agent.Close()
 
-		container, err := client.LoadContainer(ctx, id)
+		stream, err := agent.Exec(ctx)
 		if err != nil {
 			return err
 		}
-		spec, err := container.Spec(ctx)
-		if err != nil {
-			return err
+		s := &execStream{
+			stream: stream,
 		}
-		task, err := container.Task(ctx, nil)
-		if err != nil {
+		if err := s.send(&v1.ExecRequest{
+			ID:   id,
+			Args: args,
+			Env:  clix.StringSlice("env"),
+			Tty:  tty,
+		}); err != nil {
 			return err
 		}
-
-		pspec := spec.Process
-		pspec.Terminal = true
-		pspec.Args = args
-
-		stdinC := &stdinCloser{
-			stdin: os.Stdin,
+		if tty {
+			con := console.Current()
+			defer con.Reset()
+			if err := con.SetRaw(); err != nil {
+				return err
+			}
+			if err := tasks.HandleConsoleResize(ctx, s, con); err != nil {
+				logrus.WithError(err).Error("console resize")
+			}
 		}
-
-		ioCreator := cio.NewCreator(
-			cio.WithStreams(stdinC, os.Stdout, os.Stderr),
-			cio.WithFIFODir(""),
-			cio.WithTerminal,
-		)
-
-		uuid := uuid.New().String()
-
-		process, err := task.Exec(ctx, uuid, pspec, ioCreator)
-		if err != nil {
-			return err
-		}
-		defer process.Delete(ctx)
-
-		stdinC.closer = func() {
-			process.CloseIO(ctx, containerd.WithStdinCloser)
-		}
-
-		statusC, err := process.Wait(ctx)
-		if err != nil {
-			return err
+		go s.copyStdin(os.Stdin)
+
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					return errors.New("exec stream closed before the process exited")
+				}
+				return err
+			}
+			if len(resp.Stdout) > 0 {
+				os.Stdout.Write(resp.Stdout)
+			}
+			if len(resp.Stderr) > 0 {
+				os.Stderr.Write(resp.Stderr)
+			}
+			if resp.Exited {
+				if resp.ExitCode != 0 {
+					return cli.NewExitError("", int(resp.ExitCode))
+				}
+				return nil
+			}
 		}
+	},
+}
 
-		con := console.Current()
-		defer con.Reset()
-		if err := con.SetRaw(); err != nil {
-			return err
-		}
+// execStream serializes the sends of stdin and resize events on the stream
+type execStream struct {
+	mu     sync.Mutex
+	stream v1.Agent_ExecClient
+}
 
-		if err := tasks.HandleConsoleResize(ctx, process, con); err != nil {
-			logrus.WithError(err).Error("console resize")
-		}
-		if err := process.Start(ctx); err != nil {
-			return err
-		}
-		status := <-statusC
-		code, _, err := status.Result()
-		if err != nil {
-			return err
-		}
-		if code != 0 {
-			return cli.NewExitError("", int(code))
-		}
-		return nil
-	},
+func (s *execStream) send(r *v1.ExecRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stream.Send(r)
 }
 
-type stdinCloser struct {
-	stdin  *os.File
-	closer func()
+func (s *execStream) Resize(_ context.Context, w, h uint32) error {
+	return s.send(&v1.ExecRequest{
+		Resize: &v1.ConsoleSize{
+			Width:  w,
+			Height: h,
+		},
+	})
 }
 
-func (s *stdinCloser) Read(p []byte) (int, error) {
-	n, err := s.stdin.Read(p)
-	if err == io.EOF {
-		if s.closer != nil {
-			s.closer()
+func (s *execStream) copyStdin(r io.Reader) {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			data := make([]byte, n)
+			copy(data, buf[:n])
+			if serr := s.send(&v1.ExecRequest{
+				Stdin: data,
+			}); serr != nil {
+				return
+			}
+		}
+		if err != nil {
+			s.send(&v1.ExecRequest{
+				CloseStdin: true,
+			})
+			return
 		}
 	}
-	return n, err
 }
@@ -0,0 +1,192 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	units "github.com/docker/go-units"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/urfave/cli"
+)
+
+var historyCommand = cli.Command{
+	Name:  "history",
+	Usage: "show the config changes between a container's revisions",
+	Action: func(clix *cli.Context) error {
+		var (
+			id  = clix.Args().First()
+			ctx = Context()
+		)
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		r, err := agent.Get(ctx, &v1.GetRequest{
+			ID: id,
+		})
+		if err != nil {
+			return err
+		}
+		for _, s := range historyOrder(r.Container.Snapshots) {
+			current := ""
+			if s.Current {
+				current = " (current)"
+			}
+			fmt.Printf("%s%s\n", s.ID, current)
+			fmt.Printf("  image: %s, created %s ago, tag: %s\n", s.Image, units.HumanDuration(time.Since(s.Created)), orDash(s.Tag))
+			if s.Config == nil {
+				fmt.Println("  config not recorded")
+				continue
+			}
+			// diff against the revision that this one was deployed from
+			previous := findSnapshot(r.Container.Snapshots, s.Previous)
+			if previous == nil || previous.Config == nil {
+				continue
+			}
+			prev, err := flattenConfig(previous.Config)
+			if err != nil {
+				return err
+			}
+			fields, err := flattenConfig(s.Config)
+			if err != nil {
+				return err
+			}
+			printFieldDiff(prev, fields)
+		}
+		return nil
+	},
+}
+
+// historyOrder returns the revisions from the oldest to the current following
+// their previous revisions, revisions abandoned by a rollback are returned last
+func historyOrder(snapshots []*v1.Snapshot) []*v1.Snapshot {
+	var (
+		chain []*v1.Snapshot
+		seen  = make(map[string]bool)
+	)
+	for _, s := range snapshots {
+		if !s.Current {
+			continue
+		}
+		for ; s != nil && !seen[s.ID]; s = findSnapshot(snapshots, s.Previous) {
+			seen[s.ID] = true
+			chain = append([]*v1.Snapshot{s}, chain...)
+		}
+		break
+	}
+	var abandoned []*v1.Snapshot
+	for _, s := range snapshots {
+		if !seen[s.ID] {
+			abandoned = append(abandoned, s)
+		}
+	}
+	sort.Slice(abandoned, func(i, j int) bool {
+		return abandoned[i].Created.Before(abandoned[j].Created)
+	})
+	return append(chain, abandoned...)
+}
+
+func findSnapshot(snapshots []*v1.Snapshot, id string) *v1.Snapshot {
+	if id == "" {
+		return nil
+	}
+	for _, s := range snapshots {
+		if s.ID == id {
+			return s
+		}
+	}
+	return nil
+}
+
+// flattenConfig returns the fields of the config by their dotted json path
+func flattenConfig(c *v1.Container) (map[string]string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	fields := make(map[string]string)
+	if err := flatten("", m, fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func flatten(prefix string, m map[string]interface{}, fields map[string]string) error {
+	for k, v := range m {
+		key := k
+		if prefix != "" {
+			key = prefix + "." + k
+		}
+		if child, ok := v.(map[string]interface{}); ok {
+			if err := flatten(key, child, fields); err != nil {
+				return err
+			}
+			continue
+		}
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		fields[key] = string(data)
+	}
+	return nil
+}
+
+func printFieldDiff(prev, next map[string]string) {
+	var keys []string
+	for k := range prev {
+		keys = append(keys, k)
+	}
+	for k := range next {
+		if _, ok := prev[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		o, inPrev := prev[k]
+		n, inNext := next[k]
+		switch {
+		case !inPrev:
+			fmt.Printf("  + %s: %s\n", k, n)
+		case !inNext:
+			fmt.Printf("  - %s: %s\n", k, o)
+		case o != n:
+			fmt.Printf("  ~ %s: %s -> %s\n", k, o, n)
+		}
+	}
+}
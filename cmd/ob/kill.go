@@ -35,6 +35,21 @@ import (
 var killCommand = cli.Command{
 	Name:  "kill",
 	Usage: "kill a running service",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "signal,s",
+			Usage: "signal name or number to send",
+			Value: "SIGTERM",
+		},
+		cli.BoolFlag{
+			Name:  "all,a",
+			Usage: "send the signal to all processes in the container",
+		},
+		cli.IntFlag{
+			Name:  "pid,p",
+			Usage: "send the signal to a single process in the container",
+		},
+	},
 	Action: func(clix *cli.Context) error {
 		var (
 			id  = clix.Args().First()
@@ -47,7 +62,10 @@ var killCommand = cli.Command{
 		}
 		defer agent.Close()
 		_, err = agent.Kill(ctx, &v1.KillRequest{
-			ID: id,
+			ID:         id,
+			SignalName: clix.String("signal"),
+			All:        clix.Bool("all"),
+			Pid:        uint32(clix.Int("pid")),
 		})
 		return err
 	},
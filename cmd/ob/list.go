@@ -53,21 +53,37 @@ var listCommand = cli.Command{
 			return err
 		}
 		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
-		const tfmt = "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\n"
-		fmt.Fprint(w, "ID\tIMAGE\tSTATUS\tIP\tCPU\tMEMORY\tPIDS\tSIZE\tREVISIONS\n")
+		const tfmt = "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\t%d\t%s\n"
+		fmt.Fprint(w, "ID\tIMAGE\tSTATUS\tIP\tCPU\tMEMORY\tPIDS\tSIZE\tREVISIONS\tRESTARTS\tLAST EXIT\n")
 		for _, c := range resp.Containers {
 			fmt.Fprintf(w, tfmt,
 				c.ID,
 				c.Image,
-				c.Status,
+				status(c),
 				c.IP,
 				time.Duration(int64(c.Cpu)),
 				fmt.Sprintf("%s/%s", units.HumanSize(c.MemoryUsage), units.HumanSize(c.MemoryLimit)),
 				fmt.Sprintf("%d/%d", c.PidUsage, c.PidLimit),
 				units.HumanSize(float64(c.FsSize)),
 				len(c.Snapshots),
+				c.Restarts,
+				lastExit(c),
 			)
 		}
 		return w.Flush()
 	},
 }
+
+func lastExit(c *v1.ContainerInfo) string {
+	if c.ExitedAt.IsZero() {
+		return "-"
+	}
+	return fmt.Sprintf("%d (%s ago)", c.ExitCode, units.HumanDuration(time.Since(c.ExitedAt)))
+}
+
+func status(c *v1.ContainerInfo) string {
+	if c.Health == "" {
+		return c.Status
+	}
+	return fmt.Sprintf("%s (%s)", c.Status, c.Health)
+}
@@ -30,11 +30,13 @@ package main
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
-	"os/exec"
 	"os/signal"
 	"syscall"
+	"time"
 
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
 	"github.com/urfave/cli"
 )
 
@@ -46,26 +48,92 @@ var logsCommand = cli.Command{
 			Name:  "follow,f",
 			Usage: "follow the logs",
 		},
+		cli.Int64Flag{
+			Name:  "tail,n",
+			Usage: "number of most recent lines to show",
+		},
+		cli.StringFlag{
+			Name:  "since",
+			Usage: "show logs since a timestamp (RFC3339) or relative duration (10m)",
+		},
+		cli.StringFlag{
+			Name:  "until",
+			Usage: "show logs until a timestamp (RFC3339) or relative duration (10m)",
+		},
+		cli.BoolFlag{
+			Name:  "stdout",
+			Usage: "only show stdout",
+		},
+		cli.BoolFlag{
+			Name:  "stderr",
+			Usage: "only show stderr",
+		},
+		cli.BoolFlag{
+			Name:  "timestamps,t",
+			Usage: "show timestamps",
+		},
 	},
 	Action: func(clix *cli.Context) error {
 		ctx := cancelContext()
-		args := []string{
-			"-t", fmt.Sprintf("orbit:%s", clix.Args().First()),
+		since, err := parseTime(clix.String("since"))
+		if err != nil {
+			return err
+		}
+		until, err := parseTime(clix.String("until"))
+		if err != nil {
+			return err
+		}
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
 		}
-		if clix.Bool("follow") {
-			args = append(args, "-f")
+		defer agent.Close()
+		stream, err := agent.Logs(ctx, &v1.LogsRequest{
+			ID:     clix.Args().First(),
+			Follow: clix.Bool("follow"),
+			Tail:   clix.Int64("tail"),
+			Since:  since,
+			Until:  until,
+			Stdout: clix.Bool("stdout"),
+			Stderr: clix.Bool("stderr"),
+		})
+		if err != nil {
+			return err
+		}
+		for {
+			e, err := stream.Recv()
+			if err != nil {
+				if err == io.EOF || ctx.Err() != nil {
+					return nil
+				}
+				return err
+			}
+			w := os.Stdout
+			if e.Stream == "stderr" {
+				w = os.Stderr
+			}
+			if clix.Bool("timestamps") {
+				fmt.Fprintf(w, "%s ", e.Timestamp.Format(time.RFC3339Nano))
+			}
+			fmt.Fprintf(w, "%s\n", e.Data)
 		}
-		cmd := exec.CommandContext(ctx, "journalctl", args...)
-		cmd.Stdout = os.Stdout
-		cmd.Stderr = os.Stderr
-		cmd.Stdin = os.Stdin
-		return cmd.Run()
 	},
 }
 
+// parseTime parses either a RFC3339 timestamp or a duration relative to now
+func parseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
 func cancelContext() context.Context {
 	ctx, cancel := context.WithCancel(context.Background())
-	s := make(chan os.Signal)
+	s := make(chan os.Signal, 1)
 	signal.Notify(s, syscall.SIGTERM, syscall.SIGINT)
 	go func() {
 		<-s
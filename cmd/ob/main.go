@@ -82,21 +82,31 @@ func main() {
 	}
 	app.Commands = []cli.Command{
 		checkpointCommand,
+		commitCommand,
 		createCommand,
 		configCommand,
+		configsCommand,
 		deleteCommand,
+		diffCommand,
+		eventsCommand,
 		execCommand,
 		getCommand,
+		historyCommand,
 		killCommand,
 		listCommand,
 		logsCommand,
 		migrateCommand,
+		pruneCommand,
 		pushCommand,
 		restoreCommand,
+		revisionsCommand,
 		rollbackCommand,
+		secretsCommand,
 		startCommand,
 		stopCommand,
+		topCommand,
 		updateCommand,
+		volumeCommand,
 	}
 	if err := app.Run(os.Args); err != nil {
 		fmt.Fprintln(os.Stderr, err)
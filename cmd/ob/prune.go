@@ -0,0 +1,88 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	units "github.com/docker/go-units"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/urfave/cli"
+)
+
+var pruneCommand = cli.Command{
+	Name:  "prune",
+	Usage: "remove old container revisions",
+	Flags: []cli.Flag{
+		cli.IntFlag{
+			Name:  "keep,k",
+			Usage: "number of revisions to keep, overrides the agent's policy",
+		},
+		cli.DurationFlag{
+			Name:  "max-age",
+			Usage: "keep revisions newer than the age, overrides the agent's policy",
+		},
+	},
+	Action: func(clix *cli.Context) error {
+		var (
+			id  = clix.Args().First()
+			ctx = Context()
+		)
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		resp, err := agent.Prune(ctx, &v1.PruneRequest{
+			ID:     id,
+			Keep:   uint32(clix.Int("keep")),
+			MaxAge: clix.Duration("max-age"),
+		})
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
+		const tfmt = "%s\t%s\t%s\n"
+		fmt.Fprint(w, "REVISION\tCREATED\tSIZE\n")
+		for _, s := range resp.Snapshots {
+			fmt.Fprintf(w, tfmt,
+				s.ID,
+				s.Created.Format(time.RFC3339),
+				units.HumanSize(float64(s.FsSize)),
+			)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		fmt.Printf("reclaimed %s\n", units.HumanSize(float64(resp.Reclaimed)))
+		return nil
+	},
+}
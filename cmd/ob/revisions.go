@@ -0,0 +1,92 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	units "github.com/docker/go-units"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/urfave/cli"
+)
+
+var revisionsCommand = cli.Command{
+	Name:  "revisions",
+	Usage: "list the revisions of a container",
+	Action: func(clix *cli.Context) error {
+		var (
+			id  = clix.Args().First()
+			ctx = Context()
+		)
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		r, err := agent.Get(ctx, &v1.GetRequest{
+			ID: id,
+		})
+		if err != nil {
+			return err
+		}
+		snapshots := r.Container.Snapshots
+		sort.Slice(snapshots, func(i, j int) bool {
+			return snapshots[i].Created.After(snapshots[j].Created)
+		})
+		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
+		const tfmt = "%s\t%s\t%s\t%s\t%s\t%s\t%s\n"
+		fmt.Fprint(w, "CURRENT\tREVISION\tIMAGE\tCREATED\tSIZE\tTAG\tPREVIOUS\n")
+		for _, s := range snapshots {
+			current := ""
+			if s.Current {
+				current = "*"
+			}
+			fmt.Fprintf(w, tfmt,
+				current,
+				s.ID,
+				s.Image,
+				fmt.Sprintf("%s ago", units.HumanDuration(time.Since(s.Created))),
+				units.HumanSize(float64(s.FsSize)),
+				orDash(s.Tag),
+				orDash(s.Previous),
+			)
+		}
+		return w.Flush()
+	},
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
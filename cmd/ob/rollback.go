@@ -35,6 +35,12 @@ import (
 var rollbackCommand = cli.Command{
 	Name:  "rollback",
 	Usage: "rollback a container to a previous revision",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "revision,r",
+			Usage: "revision id or tag to rollback or forward to",
+		},
+	},
 	Action: func(clix *cli.Context) error {
 		var (
 			id  = clix.Args().First()
@@ -46,7 +52,8 @@ var rollbackCommand = cli.Command{
 		}
 		defer agent.Close()
 		_, err = agent.Rollback(ctx, &v1.RollbackRequest{
-			ID: id,
+			ID:       id,
+			Revision: clix.String("revision"),
 		})
 		return err
 	},
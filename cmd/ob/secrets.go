@@ -0,0 +1,124 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/urfave/cli"
+)
+
+var secretsCommand = cli.Command{
+	Name:  "secrets",
+	Usage: "manage secrets mounted into containers",
+	Subcommands: []cli.Command{
+		secretsCreateCommand,
+		secretsDeleteCommand,
+		secretsListCommand,
+	},
+}
+
+var secretsCreateCommand = cli.Command{
+	Name:      "create",
+	Usage:     "create a secret from a file or stdin with -",
+	ArgsUsage: "NAME FILE",
+	Action: func(clix *cli.Context) error {
+		var (
+			name = clix.Args().First()
+			ctx  = Context()
+		)
+		data, err := readConfigData(clix.Args().Get(1))
+		if err != nil {
+			return err
+		}
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		_, err = agent.CreateSecret(ctx, &v1.CreateSecretRequest{
+			Name: name,
+			Data: data,
+		})
+		return err
+	},
+}
+
+var secretsDeleteCommand = cli.Command{
+	Name:  "delete",
+	Usage: "delete secrets",
+	Action: func(clix *cli.Context) error {
+		ctx := Context()
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		for _, name := range clix.Args() {
+			if _, err := agent.DeleteSecret(ctx, &v1.DeleteSecretRequest{
+				Name: name,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+var secretsListCommand = cli.Command{
+	Name:  "list",
+	Usage: "list secrets",
+	Action: func(clix *cli.Context) error {
+		ctx := Context()
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		resp, err := agent.ListSecrets(ctx, &v1.ListSecretsRequest{})
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
+		const tfmt = "%s\t%s\t%s\n"
+		fmt.Fprint(w, "NAME\tCREATED\tCONTAINERS\n")
+		for _, s := range resp.Secrets {
+			fmt.Fprintf(w, tfmt,
+				s.Name,
+				s.Created.Format(time.RFC3339),
+				orDash(strings.Join(s.Containers, ",")),
+			)
+		}
+		return w.Flush()
+	},
+}
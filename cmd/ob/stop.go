@@ -35,6 +35,12 @@ import (
 var stopCommand = cli.Command{
 	Name:  "stop",
 	Usage: "stop a running service",
+	Flags: []cli.Flag{
+		cli.DurationFlag{
+			Name:  "timeout,t",
+			Usage: "time to wait for the service to exit before killing it",
+		},
+	},
 	Action: func(clix *cli.Context) error {
 		var (
 			id  = clix.Args().First()
@@ -46,7 +52,8 @@ var stopCommand = cli.Command{
 		}
 		defer agent.Close()
 		_, err = agent.Stop(ctx, &v1.StopRequest{
-			ID: id,
+			ID:      id,
+			Timeout: clix.Duration("timeout"),
 		})
 		return err
 	},
@@ -28,7 +28,10 @@
 package main
 
 import (
+	"fmt"
+
 	"github.com/BurntSushi/toml"
+	"github.com/pkg/errors"
 	api "github.com/stellarproject/terraos/api/v1/orbit"
 	v1 "github.com/stellarproject/terraos/config/v1"
 	"github.com/urfave/cli"
@@ -37,6 +40,16 @@ import (
 var updateCommand = cli.Command{
 	Name:  "update",
 	Usage: "update an existing container's configuration",
+	Flags: []cli.Flag{
+		cli.DurationFlag{
+			Name:  "watch,w",
+			Usage: "watch the updated container and rollback if it exits or is unhealthy",
+		},
+		cli.StringFlag{
+			Name:  "tag",
+			Usage: "tag the new revision",
+		},
+	},
 	Action: func(clix *cli.Context) error {
 		var (
 			path = clix.Args().First()
@@ -55,9 +68,20 @@ var updateCommand = cli.Command{
 		if err != nil {
 			return err
 		}
-		_, err = agent.Update(ctx, &api.UpdateRequest{
+		resp, err := agent.Update(ctx, &api.UpdateRequest{
 			Container: c,
+			Watch:     clix.Duration("watch"),
+			Tag:       clix.String("tag"),
 		})
-		return err
+		if err != nil {
+			return err
+		}
+		if resp.RolledBack {
+			return errors.Errorf("update rolled back: %s", resp.Reason)
+		}
+		if resp.Live {
+			fmt.Println("resources updated without a restart")
+		}
+		return nil
 	},
 }
@@ -0,0 +1,150 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	units "github.com/docker/go-units"
+	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/urfave/cli"
+)
+
+var volumeCommand = cli.Command{
+	Name:  "volume",
+	Usage: "manage named volumes",
+	Subcommands: []cli.Command{
+		volumeCreateCommand,
+		volumeDeleteCommand,
+		volumeInspectCommand,
+		volumeListCommand,
+	},
+}
+
+var volumeCreateCommand = cli.Command{
+	Name:  "create",
+	Usage: "create a named volume",
+	Action: func(clix *cli.Context) error {
+		var (
+			name = clix.Args().First()
+			ctx  = Context()
+		)
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		resp, err := agent.CreateVolume(ctx, &v1.CreateVolumeRequest{
+			Name: name,
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Println(resp.Volume.Path)
+		return nil
+	},
+}
+
+var volumeDeleteCommand = cli.Command{
+	Name:  "delete",
+	Usage: "delete a named volume and its data",
+	Action: func(clix *cli.Context) error {
+		ctx := Context()
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		for _, name := range clix.Args() {
+			if _, err := agent.DeleteVolume(ctx, &v1.DeleteVolumeRequest{
+				Name: name,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	},
+}
+
+var volumeInspectCommand = cli.Command{
+	Name:  "inspect",
+	Usage: "inspect a named volume",
+	Action: func(clix *cli.Context) error {
+		var (
+			name = clix.Args().First()
+			ctx  = Context()
+		)
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		resp, err := agent.GetVolume(ctx, &v1.GetVolumeRequest{
+			Name: name,
+		})
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(os.Stdout).Encode(resp.Volume)
+	},
+}
+
+var volumeListCommand = cli.Command{
+	Name:  "list",
+	Usage: "list named volumes",
+	Action: func(clix *cli.Context) error {
+		ctx := Context()
+		agent, err := Agent(clix)
+		if err != nil {
+			return err
+		}
+		defer agent.Close()
+		resp, err := agent.ListVolumes(ctx, &v1.ListVolumesRequest{})
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 10, 1, 3, ' ', 0)
+		const tfmt = "%s\t%s\t%s\t%s\t%s\n"
+		fmt.Fprint(w, "NAME\tDRIVER\tCREATED\tSIZE\tCONTAINERS\n")
+		for _, v := range resp.Volumes {
+			fmt.Fprintf(w, tfmt,
+				v.Name,
+				v.Driver,
+				v.Created.Format(time.RFC3339),
+				units.HumanSize(float64(v.FsSize)),
+				orDash(strings.Join(v.Containers, ",")),
+			)
+		}
+		return w.Flush()
+	},
+}
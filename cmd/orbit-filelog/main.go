@@ -0,0 +1,284 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/runtime/v2/logging"
+	units "github.com/docker/go-units"
+)
+
+// the shim passes logger options as "--key value" pairs in any order
+// so all flags take a value, including compress
+var (
+	state    = flag.String("state", "/run/orbit", "agent state directory")
+	maxSize  = flag.String("max-size", "10MB", "rotate the log file after it reaches the size, 0 for no limit")
+	maxAge   = flag.Duration("max-age", 24*time.Hour, "rotate the log file after it is older than the age, 0 for no limit")
+	maxFiles = flag.Int("max-files", 5, "number of rotated files to keep")
+	compress = flag.String("compress", "none", "compression of rotated files (none, gzip)")
+)
+
+func main() {
+	flag.Parse()
+	logging.Run(log)
+}
+
+type entry struct {
+	Time   time.Time `json:"time"`
+	Stream string    `json:"stream"`
+	Log    string    `json:"log"`
+}
+
+func log(ctx context.Context, config *logging.Config, ready func() error) error {
+	size, err := units.FromHumanSize(*maxSize)
+	if err != nil {
+		return err
+	}
+	switch *compress {
+	case "none", "gzip":
+	default:
+		return fmt.Errorf("invalid compression %q", *compress)
+	}
+	dir := filepath.Join(*state, config.ID)
+	if err := os.MkdirAll(dir, 0711); err != nil {
+		return err
+	}
+	f := &rotatingFile{
+		path:     filepath.Join(dir, "container.log"),
+		maxSize:  size,
+		maxAge:   *maxAge,
+		maxFiles: *maxFiles,
+		gzip:     *compress == "gzip",
+	}
+	if err := f.open(); err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	// write both stdout and stderr to the log file
+	go copy(&wg, config.Stdout, "stdout", f)
+	go copy(&wg, config.Stderr, "stderr", f)
+	// signal that we are ready and setup for the container to be started
+	if err := ready(); err != nil {
+		return err
+	}
+	wg.Wait()
+	return nil
+}
+
+func copy(wg *sync.WaitGroup, r io.Reader, stream string, f *rotatingFile) {
+	defer wg.Done()
+	s := bufio.NewScanner(r)
+	// discard the rest of the output if a line is too long to scan
+	defer io.Copy(ioutil.Discard, r)
+	for s.Scan() {
+		data, err := json.Marshal(entry{
+			Time:   time.Now(),
+			Stream: stream,
+			Log:    s.Text(),
+		})
+		if err != nil {
+			continue
+		}
+		// keep draining the pipe on errors so that the container does not block on writes
+		if err := f.WriteLine(data); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+		}
+	}
+}
+
+// rotatingFile is a log file that is rotated by size and age,
+// keeping maxFiles rotated files as path.1 through path.N
+type rotatingFile struct {
+	mu       sync.Mutex
+	path     string
+	maxSize  int64
+	maxAge   time.Duration
+	maxFiles int
+	gzip     bool
+
+	f       *os.File
+	size    int64
+	created time.Time
+}
+
+func (r *rotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r.f = f
+	r.size = info.Size()
+	// keep the age of an existing file across shim restarts
+	r.created = time.Now()
+	if r.size > 0 {
+		r.created = fileCreated(r.path, info)
+	}
+	return nil
+}
+
+// fileCreated returns the time of the first entry in the log file,
+// falling back to its modification time
+func fileCreated(path string, info os.FileInfo) time.Time {
+	f, err := os.Open(path)
+	if err != nil {
+		return info.ModTime()
+	}
+	defer f.Close()
+	line, err := bufio.NewReader(f).ReadBytes('\n')
+	if err != nil {
+		return info.ModTime()
+	}
+	var e entry
+	if err := json.Unmarshal(line, &e); err != nil || e.Time.IsZero() {
+		return info.ModTime()
+	}
+	return e.Time
+}
+
+// shouldRotate returns true if writing n bytes exceeds the size or age limits,
+// a limit of 0 is not enforced
+func (r *rotatingFile) shouldRotate(n int) bool {
+	if r.size == 0 {
+		return false
+	}
+	return (r.maxSize > 0 && r.size+int64(n) > r.maxSize) ||
+		(r.maxAge > 0 && time.Since(r.created) > r.maxAge)
+}
+
+func (r *rotatingFile) WriteLine(data []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// reopen the file if a previous rotation failed after closing it
+	if r.f == nil {
+		if err := r.open(); err != nil {
+			return err
+		}
+	}
+	if r.shouldRotate(len(data) + 1) {
+		if err := r.rotate(); err != nil {
+			return err
+		}
+	}
+	n, err := r.f.Write(append(data, '\n'))
+	r.size += int64(n)
+	return err
+}
+
+func (r *rotatingFile) rotate() error {
+	err := r.f.Close()
+	r.f = nil
+	if err != nil {
+		return err
+	}
+	if r.maxFiles < 1 {
+		if err := os.Remove(r.path); err != nil {
+			return err
+		}
+		return r.open()
+	}
+	// drop the oldest file and shift the rest
+	r.remove(r.maxFiles)
+	for i := r.maxFiles - 1; i > 0; i-- {
+		for _, ext := range []string{"", ".gz"} {
+			old := r.rotated(i) + ext
+			if _, err := os.Stat(old); err == nil {
+				if err := os.Rename(old, r.rotated(i+1)+ext); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	if err := os.Rename(r.path, r.rotated(1)); err != nil {
+		return err
+	}
+	if r.gzip {
+		if err := gzipFile(r.rotated(1)); err != nil {
+			return err
+		}
+	}
+	return r.open()
+}
+
+func (r *rotatingFile) rotated(i int) string {
+	return fmt.Sprintf("%s.%d", r.path, i)
+}
+
+func (r *rotatingFile) remove(i int) {
+	os.Remove(r.rotated(i))
+	os.Remove(r.rotated(i) + ".gz")
+}
+
+func (r *rotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.f == nil {
+		return nil
+	}
+	return r.f.Close()
+}
+
+func gzipFile(path string) error {
+	in, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(path+".gz", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0640)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	w := gzip.NewWriter(out)
+	if _, err := io.Copy(w, in); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
@@ -114,6 +114,49 @@ func main() {
 			Usage: "specify the logger",
 			Value: "/usr/local/bin/orbit-log",
 		},
+		cli.IntFlag{
+			Name:  "keep-revisions",
+			Usage: "number of container revisions to keep, 0 keeps all",
+		},
+		cli.DurationFlag{
+			Name:  "revision-max-age",
+			Usage: "keep container revisions newer than the age",
+		},
+		cli.StringFlag{
+			Name:  "btrfs-root",
+			Usage: "root directory of containerd's btrfs snapshotter",
+			Value: "/var/lib/containerd/io.containerd.snapshotter.v1.btrfs",
+		},
+		cli.StringFlag{
+			Name:  "volume-root",
+			Usage: "directory for named volumes",
+			Value: "/var/lib/orbit/volumes",
+		},
+		cli.StringFlag{
+			Name:  "config-root",
+			Usage: "directory for configs",
+			Value: "/var/lib/orbit/configs",
+		},
+		cli.StringFlag{
+			Name:  "secret-root",
+			Usage: "directory for encrypted secrets",
+			Value: "/var/lib/orbit/secrets",
+		},
+		cli.StringFlag{
+			Name:  "node-key",
+			Usage: "key used to encrypt secrets, generated if it does not exist",
+			Value: "/var/lib/orbit/node.key",
+		},
+		cli.StringFlag{
+			Name:  "apparmor-dir",
+			Usage: "directory of apparmor profiles to load",
+			Value: "/etc/orbit/apparmor",
+		},
+		cli.StringSliceFlag{
+			Name:  "logger-opt",
+			Usage: "key=value options passed to the logger",
+			Value: &cli.StringSlice{},
+		},
 	}
 	app.Before = func(clix *cli.Context) error {
 		if clix.GlobalBool("debug") {
@@ -127,12 +170,21 @@ func main() {
 	}
 	app.Action = func(clix *cli.Context) error {
 		c := &agent.Config{
-			ID:           clix.GlobalString("id"),
-			Iface:        clix.GlobalString("iface"),
-			State:        clix.GlobalString("state"),
-			Interval:     clix.GlobalDuration("interval"),
-			PlainRemotes: clix.GlobalStringSlice("plain-remote"),
-			Logger:       clix.GlobalString("logger"),
+			ID:             clix.GlobalString("id"),
+			Iface:          clix.GlobalString("iface"),
+			State:          clix.GlobalString("state"),
+			Interval:       clix.GlobalDuration("interval"),
+			PlainRemotes:   clix.GlobalStringSlice("plain-remote"),
+			Logger:         clix.GlobalString("logger"),
+			LoggerOpts:     clix.GlobalStringSlice("logger-opt"),
+			KeepRevisions:  clix.GlobalInt("keep-revisions"),
+			RevisionMaxAge: clix.GlobalDuration("revision-max-age"),
+			BtrfsRoot:      clix.GlobalString("btrfs-root"),
+			VolumeRoot:     clix.GlobalString("volume-root"),
+			ConfigRoot:     clix.GlobalString("config-root"),
+			SecretRoot:     clix.GlobalString("secret-root"),
+			NodeKey:        clix.GlobalString("node-key"),
+			ApparmorDir:    clix.GlobalString("apparmor-dir"),
 		}
 		if c.Iface == "" {
 			i, err := util.GetDefaultIface()
@@ -28,6 +28,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/containerd/typeurl"
 	"github.com/pkg/errors"
 	v1 "github.com/stellarproject/terraos/api/v1/orbit"
@@ -55,6 +57,28 @@ type Container struct {
 	Privileged   bool         `toml:"privileged"`
 	Pty          bool         `toml:"pty"`
 	MaskedPaths  []string     `toml:"masked_paths"`
+	Restart      *Restart     `toml:"restart"`
+	Health       *HealthCheck `toml:"health"`
+	StopSignal   string       `toml:"stop_signal"`
+	StopTimeout  Duration     `toml:"stop_timeout"`
+	DependsOn    []string     `toml:"depends_on"`
+	WaitHealthy  bool         `toml:"wait_healthy"`
+	Snapshotter  string       `toml:"snapshotter"`
+	Upgrade      string       `toml:"upgrade"`
+	Devices      []Device     `toml:"devices"`
+	UserNS       *UserNS      `toml:"userns"`
+
+	SeccompProfile     string   `toml:"seccomp_profile"`
+	SeccompUnconfined  bool     `toml:"seccomp_unconfined"`
+	ApparmorProfile    string   `toml:"apparmor_profile"`
+	ApparmorUnconfined bool     `toml:"apparmor_unconfined"`
+	DropCapabilities   []string `toml:"drop_capabilities"`
+
+	Cwd        string            `toml:"cwd"`
+	Rlimits    []Rlimit          `toml:"rlimits"`
+	Sysctls    map[string]string `toml:"sysctls"`
+	ExtraHosts []Host            `toml:"extra_hosts"`
+	Secrets    []SecretFile      `toml:"secrets"`
 }
 
 type Network struct {
@@ -80,12 +104,20 @@ func (c *Container) Proto() (*v1.Container, error) {
 			Args: c.Args,
 			Env:  c.Env,
 			Pty:  c.Pty,
+			Cwd:  c.Cwd,
 		},
+		Sysctls:  c.Sysctls,
 		Readonly: c.Readonly,
 		Security: &v1.Security{
 			Privileged:   c.Privileged,
 			Capabilities: c.Capabilities,
 			MaskedPaths:  c.MaskedPaths,
+
+			SeccompProfile:     c.SeccompProfile,
+			SeccompUnconfined:  c.SeccompUnconfined,
+			ApparmorProfile:    c.ApparmorProfile,
+			ApparmorUnconfined: c.ApparmorUnconfined,
+			DropCapabilities:   c.DropCapabilities,
 		},
 	}
 	if len(c.Networks) == 0 {
@@ -131,10 +163,32 @@ func (c *Container) Proto() (*v1.Container, error) {
 	}
 	if c.Resources != nil {
 		container.Resources = &v1.Resources{
-			Cpus:   c.Resources.CPU,
-			Memory: c.Resources.Memory,
-			Score:  c.Resources.Score,
-			NoFile: c.Resources.NoFile,
+			Cpus:              c.Resources.CPU,
+			Memory:            c.Resources.Memory,
+			Score:             c.Resources.Score,
+			NoFile:            c.Resources.NoFile,
+			CpusetCpus:        c.Resources.CPUSetCPUs,
+			CpusetMems:        c.Resources.CPUSetMems,
+			CpuShares:         c.Resources.CPUShares,
+			MemoryReservation: c.Resources.MemoryReservation,
+			MemorySwap:        c.Resources.MemorySwap,
+			Pids:              c.Resources.Pids,
+			BlkioWeight:       c.Resources.BlkioWeight,
+		}
+		for _, t := range c.Resources.DeviceThrottles {
+			container.Resources.DeviceThrottles = append(container.Resources.DeviceThrottles, &v1.DeviceThrottle{
+				Device:    t.Device,
+				ReadBps:   t.ReadBps,
+				WriteBps:  t.WriteBps,
+				ReadIops:  t.ReadIOPS,
+				WriteIops: t.WriteIOPS,
+			})
+		}
+		for _, h := range c.Resources.Hugepages {
+			container.Resources.Hugepages = append(container.Resources.Hugepages, &v1.HugepageLimit{
+				PageSize: h.PageSize,
+				Limit:    h.Limit,
+			})
 		}
 	}
 	if c.GPUs != nil {
@@ -158,23 +212,139 @@ func (c *Container) Proto() (*v1.Container, error) {
 	}
 	for _, v := range c.Configs {
 		container.Configs = append(container.Configs, &v1.ConfigFile{
+			ID:     v.ID,
+			Path:   v.Path,
+			Signal: v.Signal,
+		})
+	}
+	if c.Restart != nil {
+		container.Restart = &v1.RestartPolicy{
+			Policy:     c.Restart.Policy,
+			MaxRetries: c.Restart.MaxRetries,
+		}
+	}
+	if c.Health != nil {
+		container.Health = &v1.HealthCheck{
+			Type:     c.Health.Type,
+			Args:     c.Health.Args,
+			Port:     c.Health.Port,
+			Path:     c.Health.Path,
+			Interval: c.Health.Interval.Duration,
+			Timeout:  c.Health.Timeout.Duration,
+			Retries:  c.Health.Retries,
+		}
+	}
+	container.StopSignal = c.StopSignal
+	container.StopTimeout = c.StopTimeout.Duration
+	container.DependsOn = c.DependsOn
+	container.WaitHealthy = c.WaitHealthy
+	container.Snapshotter = c.Snapshotter
+	container.Upgrade = c.Upgrade
+	for _, r := range c.Rlimits {
+		container.Process.Rlimits = append(container.Process.Rlimits, &v1.Rlimit{
+			Type: r.Type,
+			Soft: r.Soft,
+			Hard: r.Hard,
+		})
+	}
+	for _, v := range c.Secrets {
+		container.Secrets = append(container.Secrets, &v1.SecretFile{
 			ID:   v.ID,
 			Path: v.Path,
+			Mode: v.Mode,
+		})
+	}
+	for _, h := range c.ExtraHosts {
+		container.ExtraHosts = append(container.ExtraHosts, &v1.Host{
+			IP:    h.IP,
+			Names: h.Names,
+		})
+	}
+	if c.UserNS != nil {
+		container.Security.Userns = &v1.UserNamespace{
+			Uid:    c.UserNS.UID,
+			Gid:    c.UserNS.GID,
+			Length: c.UserNS.Length,
+		}
+	}
+	for _, d := range c.Devices {
+		container.Devices = append(container.Devices, &v1.Device{
+			HostPath:      d.HostPath,
+			ContainerPath: d.ContainerPath,
+			Permissions:   d.Permissions,
 		})
 	}
 	return container, nil
 }
 
 type ConfigFile struct {
+	ID     string `toml:"id"`
+	Path   string `toml:"path"`
+	Signal string `toml:"signal"`
+}
+
+type SecretFile struct {
 	ID   string `toml:"id"`
 	Path string `toml:"path"`
+	Mode uint32 `toml:"mode"`
 }
 
 type Resources struct {
-	CPU    float64 `toml:"cpu"`
-	Memory int64   `toml:"memory"`
-	Score  int64   `toml:"score"`
-	NoFile uint64  `toml:"no_file"`
+	CPU               float64          `toml:"cpu"`
+	Memory            int64            `toml:"memory"`
+	Score             int64            `toml:"score"`
+	NoFile            uint64           `toml:"no_file"`
+	CPUSetCPUs        string           `toml:"cpuset_cpus"`
+	CPUSetMems        string           `toml:"cpuset_mems"`
+	CPUShares         uint64           `toml:"cpu_shares"`
+	MemoryReservation int64            `toml:"memory_reservation"`
+	MemorySwap        int64            `toml:"memory_swap"`
+	Pids              int64            `toml:"pids"`
+	BlkioWeight       uint32           `toml:"blkio_weight"`
+	DeviceThrottles   []DeviceThrottle `toml:"device_throttles"`
+	Hugepages         []Hugepage       `toml:"hugepages"`
+}
+
+type DeviceThrottle struct {
+	Device    string `toml:"device"`
+	ReadBps   uint64 `toml:"read_bps"`
+	WriteBps  uint64 `toml:"write_bps"`
+	ReadIOPS  uint64 `toml:"read_iops"`
+	WriteIOPS uint64 `toml:"write_iops"`
+}
+
+type Hugepage struct {
+	PageSize string `toml:"page_size"`
+	Limit    uint64 `toml:"limit"`
+}
+
+type Restart struct {
+	Policy     string `toml:"policy"`
+	MaxRetries uint32 `toml:"max_retries"`
+}
+
+type HealthCheck struct {
+	Type     string   `toml:"type"`
+	Args     []string `toml:"args"`
+	Port     uint32   `toml:"port"`
+	Path     string   `toml:"path"`
+	Interval Duration `toml:"interval"`
+	Timeout  Duration `toml:"timeout"`
+	Retries  uint32   `toml:"retries"`
+}
+
+// Duration is a time.Duration that is written as a string, i.e. "10s", in toml
+type Duration struct {
+	time.Duration
+}
+
+func (d *Duration) UnmarshalText(text []byte) (err error) {
+	d.Duration, err = time.ParseDuration(string(text))
+	return err
+}
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
 }
 
 type GPUs struct {
@@ -188,3 +358,26 @@ type Mount struct {
 	Destination string   `toml:"destination"`
 	Options     []string `toml:"options"`
 }
+
+type Device struct {
+	HostPath      string `toml:"host_path"`
+	ContainerPath string `toml:"container_path"`
+	Permissions   string `toml:"permissions"`
+}
+
+type UserNS struct {
+	UID    uint32 `toml:"uid"`
+	GID    uint32 `toml:"gid"`
+	Length uint32 `toml:"length"`
+}
+
+type Rlimit struct {
+	Type string `toml:"type"`
+	Soft uint64 `toml:"soft"`
+	Hard uint64 `toml:"hard"`
+}
+
+type Host struct {
+	IP    string   `toml:"ip"`
+	Names []string `toml:"names"`
+}
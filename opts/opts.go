@@ -27,7 +27,9 @@ package opts
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -47,27 +49,40 @@ import (
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/pkg/errors"
 	v1 "github.com/stellarproject/terraos/api/v1/orbit"
+	"github.com/stellarproject/terraos/pkg/configstore"
 	"github.com/stellarproject/terraos/pkg/iscsi"
+	"github.com/stellarproject/terraos/pkg/volume"
+	"golang.org/x/sys/unix"
 )
 
+// AllCapabilities can be used in the drop list to drop every capability
+const AllCapabilities = "ALL"
+
 const (
 	CurrentConfig          = "stellarproject.io/orbit/container"
 	LastConfig             = "stellarproject.io/orbit/container.last"
+	RevisionConfigPrefix   = "stellarproject.io/orbit/container.revision."
 	IPLabel                = "stellarproject.io/orbit/container.ip"
 	RestoreCheckpointLabel = "stellarproject.io/orbit/restore.checkpoint"
 )
 
 type Paths struct {
 	State   string
-	Cluster string
+	Configs string
+	Volumes string
 }
 
 func (p Paths) NetworkPath(id string) string {
 	return filepath.Join(p.State, "net")
 }
 
+// SecretsPath is the container's tmpfs where its secrets are written on start
+func (p Paths) SecretsPath() string {
+	return filepath.Join(p.State, "secrets")
+}
+
 func (p Paths) ConfigPath(name string) string {
-	return filepath.Join(p.Cluster, "configs", name)
+	return configstore.DataPath(p.Configs, name)
 }
 
 // WithOrbitConfig is a containerd.NewContainerOpts for spec and container configuration
@@ -96,13 +111,75 @@ func WithRollback(ctx context.Context, client *containerd.Client, c *containers.
 	return nil
 }
 
+// RevisionConfig returns the extension that stores the config deployed with the revision
+func RevisionConfig(revision string) string {
+	return RevisionConfigPrefix + revision
+}
+
+// WithRevisionConfig saves the current config with the container's current revision
+func WithRevisionConfig(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+	c.Extensions[RevisionConfig(c.SnapshotKey)] = c.Extensions[CurrentConfig]
+	return nil
+}
+
+// WithoutRevisionConfigs removes the configs saved with the revisions
+func WithoutRevisionConfigs(revisions ...string) containerd.UpdateContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		for _, r := range revisions {
+			delete(c.Extensions, RevisionConfig(r))
+		}
+		return nil
+	}
+}
+
+// WithRevisionRestore restores the config deployed with the container's current revision
+// and regenerates the container's spec from it
+func WithRevisionRestore(paths Paths) containerd.UpdateContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		config, err := revisionConfig(c)
+		if err != nil {
+			return err
+		}
+		image, err := client.GetImage(ctx, c.Image)
+		if err != nil {
+			return err
+		}
+		return WithOrbitConfig(paths, config, image)(ctx, client, c)
+	}
+}
+
+func revisionConfig(c *containers.Container) (*v1.Container, error) {
+	if d, ok := c.Extensions[RevisionConfig(c.SnapshotKey)]; ok {
+		return UnmarshalConfig(&d)
+	}
+	// revisions created before configs were saved with them fall back to the
+	// last config for the same image or the current config with the revision's image
+	if d, ok := c.Extensions[LastConfig]; ok && d.Value != nil {
+		config, err := UnmarshalConfig(&d)
+		if err != nil {
+			return nil, err
+		}
+		if config.Image == c.Image {
+			return config, nil
+		}
+	}
+	d := c.Extensions[CurrentConfig]
+	config, err := UnmarshalConfig(&d)
+	if err != nil {
+		return nil, err
+	}
+	config.Image = c.Image
+	return config, nil
+}
+
 func specOpt(paths Paths, container *v1.Container, image containerd.Image) oci.SpecOpts {
 	opts := []oci.SpecOpts{
 		oci.WithImageConfigArgs(image, container.Process.Args),
 		oci.WithHostLocaltime,
 		oci.WithEnv(container.Process.Env),
-		withMounts(container.Mounts),
+		withMounts(paths, container.Mounts, container.Security.Userns),
 		withConfigs(paths, container.Configs),
+		withSecrets(paths, container.Secrets),
 		oci.WithHostname(container.ID),
 	}
 
@@ -114,13 +191,18 @@ func specOpt(paths Paths, container *v1.Container, image containerd.Image) oci.S
 	} else {
 		opts = append(opts,
 			oci.WithNoNewPrivileges,
-			apparmor.WithDefaultProfile("orbit"),
-			seccomp.WithDefaultProfile(),
+			withApparmor(container.Security),
 		)
 	}
 	if len(container.Security.MaskedPaths) > 0 {
 		opts = append(opts, oci.WithMaskedPaths(container.Security.MaskedPaths))
 	}
+	if container.Process.Cwd != "" {
+		opts = append(opts, oci.WithProcessCwd(container.Process.Cwd))
+	}
+	if len(container.Sysctls) > 0 {
+		opts = append(opts, withSysctls(container.Sysctls))
+	}
 	if container.Process.Pty {
 		opts = append(opts, oci.WithTTY)
 	}
@@ -128,7 +210,7 @@ func specOpt(paths Paths, container *v1.Container, image containerd.Image) oci.S
 		container.Networks[0].TypeUrl == proto.MessageName(&v1.HostNetwork{}) {
 		opts = append(opts, oci.WithHostHostsFile, oci.WithHostResolvconf, oci.WithHostNamespace(specs.NetworkNamespace))
 	} else {
-		opts = append(opts, oci.WithHostResolvconf, WithContainerHostsFile(paths.State), oci.WithLinuxNamespace(specs.LinuxNamespace{
+		opts = append(opts, oci.WithHostResolvconf, WithContainerHostsFile(paths.State, container.ExtraHosts), oci.WithLinuxNamespace(specs.LinuxNamespace{
 			Type: specs.NetworkNamespace,
 			Path: paths.NetworkPath(container.ID),
 		}),
@@ -144,6 +226,16 @@ func specOpt(paths Paths, container *v1.Container, image containerd.Image) oci.S
 		),
 		)
 	}
+	if container.Security.Userns != nil {
+		opts = append(opts, withUserNamespace(container.Security.Userns))
+	}
+	// rlimits are merged with the nofile limit of the resources
+	if len(container.Process.Rlimits) > 0 {
+		opts = append(opts, withRlimits(container.Process.Rlimits))
+	}
+	if len(container.Devices) > 0 {
+		opts = append(opts, withDevices(container.Devices))
+	}
 	if container.Process.User != nil {
 		opts = append(opts, oci.WithUIDGID(container.Process.User.Uid, container.Process.User.Gid))
 	}
@@ -151,20 +243,77 @@ func specOpt(paths Paths, container *v1.Container, image containerd.Image) oci.S
 		opts = append(opts, oci.WithRootFSReadonly())
 	}
 	// make sure this opt is run after the user has been set
-	opts = append(opts, withProcessCaps(container.Security.Capabilities))
+	opts = append(opts, withProcessCaps(container.Security.Capabilities, container.Security.DropCapabilities))
+	// the default seccomp profile depends on the process capabilities
+	if !container.Security.Privileged {
+		opts = append(opts, withSeccomp(container.Security))
+	}
 	return oci.Compose(opts...)
 }
 
-func withProcessCaps(capabilities []string) oci.SpecOpts {
+func withApparmor(security *v1.Security) oci.SpecOpts {
+	switch {
+	case security.ApparmorUnconfined:
+		return apparmor.WithProfile("")
+	case security.ApparmorProfile != "":
+		return apparmor.WithProfile(security.ApparmorProfile)
+	}
+	return apparmor.WithDefaultProfile("orbit")
+}
+
+func withSeccomp(security *v1.Security) oci.SpecOpts {
+	switch {
+	case security.SeccompUnconfined:
+		return func(ctx context.Context, _ oci.Client, c *containers.Container, s *oci.Spec) error {
+			s.Linux.Seccomp = nil
+			return nil
+		}
+	case security.SeccompProfile != "":
+		return func(ctx context.Context, _ oci.Client, c *containers.Container, s *oci.Spec) error {
+			profile, err := ParseSeccompProfile(security.SeccompProfile)
+			if err != nil {
+				return err
+			}
+			s.Linux.Seccomp = profile
+			return nil
+		}
+	}
+	return seccomp.WithDefaultProfile()
+}
+
+// ParseSeccompProfile parses an inline json seccomp profile or reads it from a file
+func ParseSeccompProfile(profile string) (*specs.LinuxSeccomp, error) {
+	data := []byte(profile)
+	if !strings.HasPrefix(strings.TrimSpace(profile), "{") {
+		var err error
+		if data, err = ioutil.ReadFile(profile); err != nil {
+			return nil, errors.Wrap(err, "read seccomp profile")
+		}
+	}
+	var s specs.LinuxSeccomp
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, errors.Wrap(err, "decode seccomp profile")
+	}
+	return &s, nil
+}
+
+func withProcessCaps(add, drop []string) oci.SpecOpts {
 	return func(ctx context.Context, _ oci.Client, c *containers.Container, s *oci.Spec) error {
-		if len(capabilities) == 0 {
+		if len(add) == 0 && len(drop) == 0 {
 			return nil
 		}
 		set := make(map[string]struct{})
 		for _, s := range s.Process.Capabilities.Bounding {
 			set[s] = struct{}{}
 		}
-		for _, cc := range capabilities {
+		for _, cc := range drop {
+			if cc == AllCapabilities {
+				set = make(map[string]struct{})
+				break
+			}
+			delete(set, cc)
+		}
+		for _, cc := range add {
 			set[cc] = struct{}{}
 		}
 		ss := stringSet(set)
@@ -172,7 +321,7 @@ func withProcessCaps(capabilities []string) oci.SpecOpts {
 		s.Process.Capabilities.Effective = ss
 		s.Process.Capabilities.Permitted = ss
 		s.Process.Capabilities.Inheritable = ss
-		if s.Process.User.UID != 0 {
+		if s.Process.User.UID != 0 && len(add) > 0 {
 			s.Process.Capabilities.Ambient = ss
 		}
 		return nil
@@ -211,20 +360,15 @@ func toGpuCaps(ss []string) (o []nvidia.Capability) {
 
 func withResources(r *v1.Resources) oci.SpecOpts {
 	return func(ctx context.Context, _ oci.Client, c *containers.Container, s *oci.Spec) error {
-		if r.Memory > 0 {
-			limit := r.Memory * 1024 * 1024
-			s.Linux.Resources.Memory = &specs.LinuxMemory{
-				Limit: &limit,
-			}
+		resources, err := LinuxResources(r)
+		if err != nil {
+			return err
 		}
-		if r.Cpus > 0 {
-			period := uint64(100000)
-			quota := int64(r.Cpus * 100000.0)
-			s.Linux.Resources.CPU = &specs.LinuxCPU{
-				Quota:  &quota,
-				Period: &period,
-			}
+		if s.Linux.Resources != nil {
+			// keep the device rules of the default spec
+			resources.Devices = append(s.Linux.Resources.Devices, resources.Devices...)
 		}
+		s.Linux.Resources = resources
 		if r.Score != 0 {
 			score := int(r.Score)
 			s.Process.OOMScoreAdj = &score
@@ -242,17 +386,345 @@ func withResources(r *v1.Resources) oci.SpecOpts {
 	}
 }
 
-func withMounts(mounts []*v1.Mount) oci.SpecOpts {
+const mb = 1024 * 1024
+
+// LinuxResources returns the cgroup resources of the container
+func LinuxResources(r *v1.Resources) (*specs.LinuxResources, error) {
+	var (
+		resources specs.LinuxResources
+		memory    specs.LinuxMemory
+		cpu       specs.LinuxCPU
+	)
+	if r.Memory > 0 {
+		limit := r.Memory * mb
+		memory.Limit = &limit
+	}
+	if r.MemoryReservation > 0 {
+		reservation := r.MemoryReservation * mb
+		memory.Reservation = &reservation
+	}
+	if r.MemorySwap != 0 {
+		swap := r.MemorySwap
+		if swap > 0 {
+			if swap < r.Memory {
+				return nil, errors.New("memory swap must be larger than the memory limit")
+			}
+			swap = swap * mb
+		}
+		memory.Swap = &swap
+	}
+	if memory != (specs.LinuxMemory{}) {
+		resources.Memory = &memory
+	}
+	if r.Cpus > 0 {
+		period := uint64(100000)
+		quota := int64(r.Cpus * 100000.0)
+		cpu.Quota = &quota
+		cpu.Period = &period
+	}
+	if r.CpuShares > 0 {
+		shares := r.CpuShares
+		cpu.Shares = &shares
+	}
+	cpu.Cpus = r.CpusetCpus
+	cpu.Mems = r.CpusetMems
+	if cpu != (specs.LinuxCPU{}) {
+		resources.CPU = &cpu
+	}
+	if r.Pids != 0 {
+		resources.Pids = &specs.LinuxPids{
+			Limit: r.Pids,
+		}
+	}
+	if r.BlkioWeight > 0 || len(r.DeviceThrottles) > 0 {
+		blkio, err := blockIO(r)
+		if err != nil {
+			return nil, err
+		}
+		resources.BlockIO = blkio
+	}
+	for _, h := range r.Hugepages {
+		resources.HugepageLimits = append(resources.HugepageLimits, specs.LinuxHugepageLimit{
+			Pagesize: h.PageSize,
+			Limit:    h.Limit * mb,
+		})
+	}
+	return &resources, nil
+}
+
+func blockIO(r *v1.Resources) (*specs.LinuxBlockIO, error) {
+	var blkio specs.LinuxBlockIO
+	if r.BlkioWeight > 0 {
+		if r.BlkioWeight < 10 || r.BlkioWeight > 1000 {
+			return nil, errors.Errorf("blkio weight %d is not between 10 and 1000", r.BlkioWeight)
+		}
+		weight := uint16(r.BlkioWeight)
+		blkio.Weight = &weight
+	}
+	throttle := func(major, minor int64, rate uint64) specs.LinuxThrottleDevice {
+		d := specs.LinuxThrottleDevice{
+			Rate: rate,
+		}
+		d.Major, d.Minor = major, minor
+		return d
+	}
+	for _, t := range r.DeviceThrottles {
+		dev, err := deviceFromPath(t.Device)
+		if err != nil {
+			return nil, errors.Wrapf(err, "throttle device %s", t.Device)
+		}
+		if dev.Type != "b" {
+			return nil, errors.Errorf("throttle device %s is not a block device", t.Device)
+		}
+		if t.ReadBps > 0 {
+			blkio.ThrottleReadBpsDevice = append(blkio.ThrottleReadBpsDevice, throttle(dev.Major, dev.Minor, t.ReadBps))
+		}
+		if t.WriteBps > 0 {
+			blkio.ThrottleWriteBpsDevice = append(blkio.ThrottleWriteBpsDevice, throttle(dev.Major, dev.Minor, t.WriteBps))
+		}
+		if t.ReadIops > 0 {
+			blkio.ThrottleReadIOPSDevice = append(blkio.ThrottleReadIOPSDevice, throttle(dev.Major, dev.Minor, t.ReadIops))
+		}
+		if t.WriteIops > 0 {
+			blkio.ThrottleWriteIOPSDevice = append(blkio.ThrottleWriteIOPSDevice, throttle(dev.Major, dev.Minor, t.WriteIops))
+		}
+	}
+	return &blkio, nil
+}
+
+const defaultUserNamespaceLength = 65536
+
+func withUserNamespace(userns *v1.UserNamespace) oci.SpecOpts {
+	return func(ctx context.Context, _ oci.Client, c *containers.Container, s *oci.Spec) error {
+		length := userns.Length
+		if length == 0 {
+			length = defaultUserNamespaceLength
+		}
+		s.Linux.Namespaces = append(s.Linux.Namespaces, specs.LinuxNamespace{
+			Type: specs.UserNamespace,
+		})
+		s.Linux.UIDMappings = []specs.LinuxIDMapping{
+			{
+				ContainerID: 0,
+				HostID:      userns.Uid,
+				Size:        length,
+			},
+		}
+		s.Linux.GIDMappings = []specs.LinuxIDMapping{
+			{
+				ContainerID: 0,
+				HostID:      userns.Gid,
+				Size:        length,
+			},
+		}
+		// sysfs cannot be mounted in a user namespace that does not own the
+		// network namespace so bind the host's read only instead
+		for i, m := range s.Mounts {
+			if m.Destination == "/sys" {
+				s.Mounts[i] = specs.Mount{
+					Destination: "/sys",
+					Type:        "bind",
+					Source:      "/sys",
+					Options:     []string{"rbind", "nosuid", "noexec", "nodev", "ro"},
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// HostUser returns the host uid and gid of the container's user in the user namespace
+func HostUser(userns *v1.UserNamespace, uid, gid uint32) (uint32, uint32) {
+	if userns == nil {
+		return uid, gid
+	}
+	return userns.Uid + uid, userns.Gid + gid
+}
+
+// ContainerUser returns the uid and gid in the user namespace of the host uid and gid,
+// false is returned if either is not mapped into the namespace
+func ContainerUser(userns *v1.UserNamespace, uid, gid uint32) (uint32, uint32, bool) {
+	if userns == nil {
+		return uid, gid, true
+	}
+	length := userns.Length
+	if length == 0 {
+		length = defaultUserNamespaceLength
+	}
+	mapped := func(id, start uint32) bool {
+		return id >= start && id-start < length
+	}
+	if !mapped(uid, userns.Uid) || !mapped(gid, userns.Gid) {
+		return uid, gid, false
+	}
+	return uid - userns.Uid, gid - userns.Gid, true
+}
+
+func withSysctls(sysctls map[string]string) oci.SpecOpts {
+	return func(ctx context.Context, _ oci.Client, c *containers.Container, s *oci.Spec) error {
+		if s.Linux.Sysctl == nil {
+			s.Linux.Sysctl = make(map[string]string)
+		}
+		for k, v := range sysctls {
+			s.Linux.Sysctl[k] = v
+		}
+		return nil
+	}
+}
+
+func withRlimits(rlimits []*v1.Rlimit) oci.SpecOpts {
+	return func(ctx context.Context, _ oci.Client, c *containers.Container, s *oci.Spec) error {
+		for _, r := range rlimits {
+			tpe, err := RlimitType(r.Type)
+			if err != nil {
+				return err
+			}
+			limit := specs.POSIXRlimit{
+				Type: tpe,
+				Soft: r.Soft,
+				Hard: r.Hard,
+			}
+			var replaced bool
+			for i, l := range s.Process.Rlimits {
+				if l.Type == tpe {
+					s.Process.Rlimits[i] = limit
+					replaced = true
+				}
+			}
+			if !replaced {
+				s.Process.Rlimits = append(s.Process.Rlimits, limit)
+			}
+		}
+		return nil
+	}
+}
+
+var rlimits = map[string]struct{}{
+	"RLIMIT_AS":         {},
+	"RLIMIT_CORE":       {},
+	"RLIMIT_CPU":        {},
+	"RLIMIT_DATA":       {},
+	"RLIMIT_FSIZE":      {},
+	"RLIMIT_LOCKS":      {},
+	"RLIMIT_MEMLOCK":    {},
+	"RLIMIT_MSGQUEUE":   {},
+	"RLIMIT_NICE":       {},
+	"RLIMIT_NOFILE":     {},
+	"RLIMIT_NPROC":      {},
+	"RLIMIT_RSS":        {},
+	"RLIMIT_RTPRIO":     {},
+	"RLIMIT_RTTIME":     {},
+	"RLIMIT_SIGPENDING": {},
+	"RLIMIT_STACK":      {},
+}
+
+// RlimitType returns the spec type of the rlimit, i.e. nproc is RLIMIT_NPROC
+func RlimitType(name string) (string, error) {
+	tpe := strings.ToUpper(name)
+	if !strings.HasPrefix(tpe, "RLIMIT_") {
+		tpe = "RLIMIT_" + tpe
+	}
+	if _, ok := rlimits[tpe]; !ok {
+		return "", errors.Errorf("invalid rlimit %q", name)
+	}
+	return tpe, nil
+}
+
+func withDevices(devices []*v1.Device) oci.SpecOpts {
+	return func(ctx context.Context, _ oci.Client, c *containers.Container, s *oci.Spec) error {
+		if s.Linux.Resources == nil {
+			s.Linux.Resources = &specs.LinuxResources{}
+		}
+		for _, d := range devices {
+			permissions := d.Permissions
+			if permissions == "" {
+				permissions = "rwm"
+			}
+			if strings.Trim(permissions, "rwm") != "" {
+				return errors.Errorf("invalid device permissions %q for %s", permissions, d.HostPath)
+			}
+			dev, err := deviceFromPath(d.HostPath)
+			if err != nil {
+				return errors.Wrapf(err, "device %s", d.HostPath)
+			}
+			if d.ContainerPath != "" {
+				dev.Path = d.ContainerPath
+			}
+			s.Linux.Devices = append(removeDevice(s.Linux.Devices, dev.Path), *dev)
+			s.Linux.Resources.Devices = append(s.Linux.Resources.Devices, specs.LinuxDeviceCgroup{
+				Type:   dev.Type,
+				Allow:  true,
+				Major:  &dev.Major,
+				Minor:  &dev.Minor,
+				Access: permissions,
+			})
+		}
+		return nil
+	}
+}
+
+// deviceFromPath follows symlinks so that stable names such as
+// /dev/serial/by-id can be used for the host path
+func deviceFromPath(path string) (*specs.LinuxDevice, error) {
+	var stat unix.Stat_t
+	if err := unix.Stat(path, &stat); err != nil {
+		return nil, err
+	}
+	var (
+		dev   = uint64(stat.Rdev)
+		major = unix.Major(dev)
+		minor = unix.Minor(dev)
+		tpe   string
+	)
+	switch stat.Mode & unix.S_IFMT {
+	case unix.S_IFBLK:
+		tpe = "b"
+	case unix.S_IFCHR:
+		tpe = "c"
+	default:
+		return nil, oci.ErrNotADevice
+	}
+	mode := os.FileMode(stat.Mode &^ unix.S_IFMT)
+	return &specs.LinuxDevice{
+		Type:     tpe,
+		Path:     path,
+		Major:    int64(major),
+		Minor:    int64(minor),
+		FileMode: &mode,
+		UID:      &stat.Uid,
+		GID:      &stat.Gid,
+	}, nil
+}
+
+func removeDevice(devices []specs.LinuxDevice, path string) (o []specs.LinuxDevice) {
+	for _, d := range devices {
+		if d.Path != path {
+			o = append(o, d)
+		}
+	}
+	return o
+}
+
+func withMounts(paths Paths, mounts []*v1.Mount, userns *v1.UserNamespace) oci.SpecOpts {
 	return func(ctx context.Context, _ oci.Client, c *containers.Container, s *oci.Spec) error {
 		for _, cm := range mounts {
 			var (
-				tpe    = cm.Type
-				source = cm.Source
+				tpe     = cm.Type
+				source  = cm.Source
+				options = cm.Options
 			)
 			switch cm.Type {
+			case "volume":
+				// volumes are created by the agent before the task is started
+				tpe = "bind"
+				source = volume.DataPath(paths.Volumes, cm.Source)
+				if !hasBindOption(options) {
+					options = append([]string{"rbind"}, options...)
+				}
 			case "bind":
 				// create source if it does not exist
-				if err := createHostDir(cm.Source, int(s.Process.User.UID), int(s.Process.User.GID)); err != nil {
+				uid, gid := HostUser(userns, s.Process.User.UID, s.Process.User.GID)
+				if err := createHostDir(cm.Source, int(uid), int(gid)); err != nil {
 					return err
 				}
 			case "iscsi":
@@ -278,13 +750,22 @@ func withMounts(mounts []*v1.Mount) oci.SpecOpts {
 				Type:        tpe,
 				Source:      source,
 				Destination: cm.Destination,
-				Options:     cm.Options,
+				Options:     options,
 			})
 		}
 		return nil
 	}
 }
 
+func hasBindOption(options []string) bool {
+	for _, o := range options {
+		if o == "bind" || o == "rbind" {
+			return true
+		}
+	}
+	return false
+}
+
 func ParseISCSI(s string) (string, string, error) {
 	parts := strings.SplitN(s, "|", 2)
 	if len(parts) != 2 {
@@ -326,7 +807,37 @@ func withConfigs(paths Paths, files []*v1.ConfigFile) oci.SpecOpts {
 	}
 }
 
-func WriteHostsFiles(root, id string) (string, string, error) {
+// withSecrets only adds the mounts, the secrets are written by the agent on start
+// so that their data is never stored with the container
+func withSecrets(paths Paths, secrets []*v1.SecretFile) oci.SpecOpts {
+	return func(ctx context.Context, _ oci.Client, c *containers.Container, s *oci.Spec) error {
+		for _, f := range secrets {
+			s.Mounts = append(s.Mounts, specs.Mount{
+				Type:        "bind",
+				Source:      filepath.Join(paths.SecretsPath(), f.ID),
+				Destination: secretPath(f),
+				Options: []string{
+					"ro",
+					"rbind",
+					"nosuid",
+					"nodev",
+					"noexec",
+				},
+			})
+		}
+		return nil
+	}
+}
+
+// secretPath returns the path of the secret in the container
+func secretPath(f *v1.SecretFile) string {
+	if f.Path != "" {
+		return f.Path
+	}
+	return filepath.Join("/run/secrets", f.ID)
+}
+
+func WriteHostsFiles(root, id string, hosts []*v1.Host) (string, string, error) {
 	if err := os.MkdirAll(root, 0711); err != nil {
 		return "", "", err
 	}
@@ -348,6 +859,11 @@ func WriteHostsFiles(root, id string) (string, string, error) {
 	if _, err := f.WriteString("::1     localhost ip6-localhost ip6-loopback\n"); err != nil {
 		return "", "", err
 	}
+	for _, h := range hosts {
+		if _, err := f.WriteString(fmt.Sprintf("%-15s %s\n", h.IP, strings.Join(h.Names, " "))); err != nil {
+			return "", "", err
+		}
+	}
 	hpath := filepath.Join(root, "hostname")
 	hf, err := os.Create(hpath)
 	if err != nil {
@@ -359,9 +875,9 @@ func WriteHostsFiles(root, id string) (string, string, error) {
 	return path, hpath, nil
 }
 
-func WithContainerHostsFile(root string) oci.SpecOpts {
+func WithContainerHostsFile(root string, extra []*v1.Host) oci.SpecOpts {
 	return func(ctx context.Context, _ oci.Client, c *containers.Container, s *oci.Spec) error {
-		hosts, hostname, err := WriteHostsFiles(root, c.ID)
+		hosts, hostname, err := WriteHostsFiles(root, c.ID, extra)
 		if err != nil {
 			return err
 		}
@@ -29,6 +29,7 @@ package btrfs
 
 import (
 	"os/exec"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -54,6 +55,22 @@ func Snapshot(source, dest string) error {
 	return nil
 }
 
+func DeleteSubvolume(path string) error {
+	if err := Btrfs("subvolume", "delete", path); err != nil {
+		return errors.Wrapf(err, "delete subvolume %s", path)
+	}
+	return nil
+}
+
+// RootID returns the id of the subvolume that contains the path
+func RootID(path string) (string, error) {
+	out, err := exec.Command("btrfs", "inspect-internal", "rootid", path).CombinedOutput()
+	if err != nil {
+		return "", errors.Wrapf(err, "%s", out)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
 func Btrfs(args ...string) error {
 	out, err := exec.Command("btrfs", args...).CombinedOutput()
 	if err != nil {
@@ -0,0 +1,334 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package configstore
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/opencontainers/go-digest"
+	"github.com/pkg/errors"
+)
+
+const (
+	metadataFile = "config.json"
+	dataFile     = "data"
+	versionsDir  = "versions"
+	// keepVersions is the number of previous versions kept for each config
+	keepVersions = 10
+)
+
+var validName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// Config is a named and versioned blob that is mounted into containers
+type Config struct {
+	Name    string        `json:"name"`
+	Version uint64        `json:"version"`
+	Digest  digest.Digest `json:"digest"`
+	Size    int64         `json:"size"`
+	Created time.Time     `json:"created"`
+	Updated time.Time     `json:"updated"`
+	// Path is the file with the current data of the config
+	Path string `json:"-"`
+}
+
+// DataPath returns the file with the current data of the named config in the root
+func DataPath(root, name string) string {
+	return filepath.Join(root, name, dataFile)
+}
+
+// Store keeps configs on disk with their previous versions
+type Store struct {
+	root string
+	mu   sync.Mutex
+}
+
+func NewStore(root string) (*Store, error) {
+	if err := os.MkdirAll(root, 0711); err != nil {
+		return nil, err
+	}
+	s := &Store{
+		root: root,
+	}
+	if err := s.repair(); err != nil {
+		return nil, errors.Wrap(err, "repair configs")
+	}
+	return s, nil
+}
+
+// repair restores data files that do not match their current version,
+// i.e. after a crash while the data was being copied
+func (s *Store) repair() error {
+	configs, err := s.List()
+	if err != nil {
+		return err
+	}
+	for _, c := range configs {
+		data, err := ioutil.ReadFile(c.Path)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err == nil && digest.FromBytes(data) == c.Digest {
+			continue
+		}
+		if err := copyData(c.Path, s.versionPath(c.Name, c.Version)); err != nil {
+			return errors.Wrapf(err, "restore config %s", c.Name)
+		}
+	}
+	return nil
+}
+
+func (s *Store) Create(name string, data []byte) (*Config, error) {
+	if !validName.MatchString(name) {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid config name %q", name)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	dir := filepath.Join(s.root, name)
+	if err := os.Mkdir(dir, 0711); err != nil {
+		if os.IsExist(err) {
+			return nil, errors.Wrapf(errdefs.ErrAlreadyExists, "config %s", name)
+		}
+		return nil, err
+	}
+	if err := os.Mkdir(filepath.Join(dir, versionsDir), 0711); err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	now := time.Now()
+	c := &Config{
+		Name:    name,
+		Created: now,
+	}
+	if err := s.write(c, data, now); err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	return c, nil
+}
+
+// Update writes a new version of the config, the version is not changed
+// if the data is the same as the current version
+func (s *Store) Update(name string, data []byte) (*Config, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, err := s.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if c.Digest == digest.FromBytes(data) {
+		return c, nil
+	}
+	if err := s.write(c, data, time.Now()); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *Store) write(c *Config, data []byte, now time.Time) error {
+	dir := filepath.Join(s.root, c.Name)
+	c.Version++
+	c.Digest = digest.FromBytes(data)
+	c.Size = int64(len(data))
+	c.Updated = now
+	c.Path = DataPath(s.root, c.Name)
+	version := s.versionPath(c.Name, c.Version)
+	if err := atomicWrite(version, data, 0600); err != nil {
+		return err
+	}
+	// the data file is bind mounted into running containers so it cannot be replaced,
+	// copy the complete version into its inode to keep the time it is partial short
+	if err := copyData(c.Path, version); err != nil {
+		return err
+	}
+	if c.Version > keepVersions {
+		if err := os.Remove(s.versionPath(c.Name, c.Version-keepVersions)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	meta, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return atomicWrite(filepath.Join(dir, metadataFile), meta, 0600)
+}
+
+// atomicWrite writes the data to a temporary file that is renamed to the path
+func atomicWrite(path string, data []byte, mode os.FileMode) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(f.Name(), mode); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// copyData replaces the contents of the data file with src without changing its inode
+func copyData(path, src string) error {
+	r, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (s *Store) Get(name string) (*Config, error) {
+	if !validName.MatchString(name) {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid config name %q", name)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(s.root, name, metadataFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Wrapf(errdefs.ErrNotFound, "config %s", name)
+		}
+		return nil, err
+	}
+	var c Config
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+	c.Path = DataPath(s.root, name)
+	return &c, nil
+}
+
+// Data returns the data of the config's version, 0 is the current version
+func (s *Store) Data(name string, version uint64) ([]byte, error) {
+	c, err := s.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	if version == 0 {
+		version = c.Version
+	}
+	data, err := ioutil.ReadFile(s.versionPath(name, version))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Wrapf(errdefs.ErrNotFound, "config %s version %d", name, version)
+		}
+		return nil, err
+	}
+	return data, nil
+}
+
+// Versions returns the versions of the config that are kept
+func (s *Store) Versions(name string) ([]uint64, error) {
+	if _, err := s.Get(name); err != nil {
+		return nil, err
+	}
+	files, err := ioutil.ReadDir(filepath.Join(s.root, name, versionsDir))
+	if err != nil {
+		return nil, err
+	}
+	var versions []uint64
+	for _, f := range files {
+		v, err := strconv.ParseUint(f.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i] < versions[j]
+	})
+	return versions, nil
+}
+
+func (s *Store) List() ([]*Config, error) {
+	dirs, err := ioutil.ReadDir(s.root)
+	if err != nil {
+		return nil, err
+	}
+	var out []*Config
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		c, err := s.Get(d.Name())
+		if err != nil {
+			if errdefs.IsNotFound(err) || errdefs.IsInvalidArgument(err) {
+				continue
+			}
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, nil
+}
+
+func (s *Store) Remove(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.Get(name); err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(s.root, name))
+}
+
+func (s *Store) versionPath(name string, version uint64) string {
+	return filepath.Join(s.root, name, versionsDir, fmt.Sprint(version))
+}
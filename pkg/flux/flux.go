@@ -30,18 +30,27 @@ package flux
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/diff"
 	"github.com/containerd/containerd/diff/apply"
 	"github.com/containerd/containerd/errdefs"
 	"github.com/containerd/containerd/mount"
 	"github.com/containerd/containerd/rootfs"
 	"github.com/containerd/containerd/snapshots"
+	"github.com/containerd/continuity/fs"
 	"github.com/opencontainers/image-spec/identity"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/pkg/errors"
+	"github.com/stellarproject/terraos/pkg/btrfs"
 )
 
 const (
@@ -50,6 +59,19 @@ const (
 	PreviousLabel    = "stellarproject.io/orbit/revision.previous"
 	ImageLabel       = "stellarproject.io/orbit/revision.image"
 	ContainerIDLabel = "stellarproject.io/orbit/revision.container"
+	TagLabel         = "stellarproject.io/orbit/revision.tag"
+	// RemapLabel is set on containers running in a user namespace with the
+	// host uid:gid of the container's root so that revisions are owned by it
+	RemapLabel = "stellarproject.io/orbit/revision.remap"
+)
+
+const (
+	// UpgradeCopy copies the changes in the rw layer to the new revision
+	UpgradeCopy = "copy"
+	// UpgradeDrop starts the new revision from the image without the rw layer
+	UpgradeDrop = "drop"
+	// UpgradeBtrfs snapshots the rw layer and applies the image changes on top of it
+	UpgradeBtrfs = "btrfs"
 )
 
 var ErrNoPreviousRevision = errors.New("no previous revision")
@@ -70,6 +92,18 @@ func WithNewSnapshot(i containerd.Image) containerd.NewContainerOpts {
 	}
 }
 
+// WithRemap shifts the ownership of the container's revisions by the uid and gid,
+// it must be set before the container's snapshot is created
+func WithRemap(uid, gid uint32) containerd.NewContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		if c.Labels == nil {
+			c.Labels = make(map[string]string)
+		}
+		c.Labels[RemapLabel] = fmt.Sprintf("%d:%d", uid, gid)
+		return nil
+	}
+}
+
 // WithUpgrade upgrades an existing container's image to a new one
 func WithUpgrade(i containerd.Image) containerd.UpdateContainerOpts {
 	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
@@ -83,6 +117,39 @@ func WithUpgrade(i containerd.Image) containerd.UpdateContainerOpts {
 	}
 }
 
+// WithDropUpgrade upgrades an existing container's image to a new one without
+// carrying over the changes in the container's rw layer
+func WithDropUpgrade(i containerd.Image) containerd.UpdateContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		revision, err := create(ctx, client, i, c, c.ID, c.SnapshotKey)
+		if err != nil {
+			return err
+		}
+		c.Image = i.Name()
+		c.SnapshotKey = revision.Key
+		return nil
+	}
+}
+
+// WithBtrfsUpgrade upgrades an existing container's image to a new one by taking a btrfs
+// snapshot of the rw layer and applying the changes between the images on top of it.
+// Files changed in both the rw layer and the new image are replaced by the image's version.
+// root is the root directory of containerd's btrfs snapshotter
+func WithBtrfsUpgrade(i containerd.Image, root string) containerd.UpdateContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		if c.Snapshotter != "btrfs" {
+			return errors.Errorf("btrfs upgrade requires the btrfs snapshotter, container uses %q", c.Snapshotter)
+		}
+		revision, err := saveBtrfs(ctx, client, i, c, root)
+		if err != nil {
+			return err
+		}
+		c.Image = i.Name()
+		c.SnapshotKey = revision.Key
+		return nil
+	}
+}
+
 // WithRollback rolls back to the previous container's revision
 func WithRollback(ctx context.Context, client *containerd.Client, c *containers.Container) error {
 	prev, err := previous(ctx, client, c)
@@ -106,6 +173,116 @@ func WithRollback(ctx context.Context, client *containerd.Client, c *containers.
 	return nil
 }
 
+// WithRevision sets the container to the revision with the snapshot key or tag
+func WithRevision(revision string) containerd.UpdateContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		found, err := Lookup(ctx, client, c, revision)
+		if err != nil {
+			return err
+		}
+		snapshotImage := found.Labels[ImageLabel]
+		if snapshotImage == "" {
+			return fmt.Errorf("snapshot %s has an empty service image label", found.Name)
+		}
+		c.Image = snapshotImage
+		c.SnapshotKey = found.Name
+		return nil
+	}
+}
+
+// WithTag tags the container's current revision, moving the tag from any other revision
+func WithTag(tag string) containerd.UpdateContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		if tag == "" {
+			return nil
+		}
+		var updates []snapshots.Info
+		if err := walkRevisions(ctx, client, c, func(si snapshots.Info) {
+			switch {
+			case si.Name == c.SnapshotKey:
+				si.Labels[TagLabel] = tag
+			case si.Labels[TagLabel] == tag:
+				delete(si.Labels, TagLabel)
+			default:
+				return
+			}
+			updates = append(updates, si)
+		}); err != nil {
+			return err
+		}
+		ss := client.SnapshotService(c.Snapshotter)
+		for _, si := range updates {
+			if _, err := ss.Update(ctx, si, "labels."+TagLabel); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// Lookup returns the snapshot of the container's revision with the key or tag
+func Lookup(ctx context.Context, client *containerd.Client, c *containers.Container, revision string) (*snapshots.Info, error) {
+	var found *snapshots.Info
+	if err := walkRevisions(ctx, client, c, func(si snapshots.Info) {
+		if si.Name == revision || si.Labels[TagLabel] == revision {
+			found = &si
+		}
+	}); err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, errors.Wrapf(errdefs.ErrNotFound, "revision %s", revision)
+	}
+	return found, nil
+}
+
+// Changes calls fn for each filesystem change between two revisions of the container.
+// An empty from compares the revision with its image and an empty to uses the current revision
+func Changes(ctx context.Context, client *containerd.Client, c *containers.Container, from, to string, fn fs.ChangeFunc) error {
+	if to == "" {
+		to = c.SnapshotKey
+	}
+	upper, err := Lookup(ctx, client, c, to)
+	if err != nil {
+		return err
+	}
+	ss := client.SnapshotService(c.Snapshotter)
+	upperMounts, err := ss.Mounts(ctx, upper.Name)
+	if err != nil {
+		return err
+	}
+	var lowerMounts []mount.Mount
+	if from == "" {
+		key := fmt.Sprintf("%s-diff-%d", upper.Name, time.Now().UnixNano())
+		if lowerMounts, err = ss.View(ctx, key, upper.Parent); err != nil {
+			return err
+		}
+		defer ss.Remove(ctx, key)
+	} else {
+		lower, err := Lookup(ctx, client, c, from)
+		if err != nil {
+			return err
+		}
+		if lowerMounts, err = ss.Mounts(ctx, lower.Name); err != nil {
+			return err
+		}
+	}
+	return mount.WithTempMount(ctx, lowerMounts, func(lowerRoot string) error {
+		return mount.WithTempMount(ctx, upperMounts, func(upperRoot string) error {
+			return fs.Changes(ctx, lowerRoot, upperRoot, fn)
+		})
+	})
+}
+
+func walkRevisions(ctx context.Context, client *containerd.Client, c *containers.Container, fn func(snapshots.Info)) error {
+	return client.SnapshotService(c.Snapshotter).Walk(ctx, func(ctx context.Context, si snapshots.Info) error {
+		if si.Labels[ContainerIDLabel] == c.ID {
+			fn(si)
+		}
+		return nil
+	})
+}
+
 // WithRevisionCleanup cleans up all revisions for a container
 func WithRevisionCleanup(ctx context.Context, client *containerd.Client, c containers.Container) error {
 	if c.Snapshotter == "" {
@@ -131,6 +308,120 @@ func WithRevisionCleanup(ctx context.Context, client *containerd.Client, c conta
 	return nil
 }
 
+// RetentionPolicy specifies the revisions of a container that are kept,
+// a revision is kept if it is one of the last Keep revisions or newer than MaxAge
+type RetentionPolicy struct {
+	// Keep is the number of most recent revisions to keep, 0 does not limit the count
+	Keep int
+	// MaxAge is the age of revisions to keep, 0 does not limit the age
+	MaxAge time.Duration
+}
+
+// Prune removes the revisions of a container that are not kept by the policy and
+// returns the removed revisions. The current revision, the previous revision
+// that it rolls back to, and tagged revisions are always kept
+func Prune(ctx context.Context, client *containerd.Client, c containers.Container, policy RetentionPolicy) ([]*Revision, error) {
+	if policy.Keep <= 0 && policy.MaxAge <= 0 {
+		return nil, nil
+	}
+	if c.Snapshotter == "" {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "container.Snapshotter must be set to prune revisions")
+	}
+	var (
+		infos []snapshots.Info
+		ss    = client.SnapshotService(c.Snapshotter)
+	)
+	if err := ss.Walk(ctx, func(ctx context.Context, si snapshots.Info) error {
+		if si.Labels[ContainerIDLabel] == c.ID {
+			infos = append(infos, si)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	keep := map[string]bool{
+		c.SnapshotKey: true,
+	}
+	for _, si := range infos {
+		if si.Name == c.SnapshotKey && si.Labels[PreviousLabel] != "" {
+			keep[si.Labels[PreviousLabel]] = true
+		}
+	}
+	// newest revisions first
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Created.After(infos[j].Created)
+	})
+	var removed []*Revision
+	for i, si := range infos {
+		if keep[si.Name] || si.Labels[TagLabel] != "" {
+			continue
+		}
+		if policy.Keep > 0 && i < policy.Keep {
+			continue
+		}
+		if policy.MaxAge > 0 && time.Since(si.Created) < policy.MaxAge {
+			continue
+		}
+		usage, err := ss.Usage(ctx, si.Name)
+		if err != nil {
+			return removed, err
+		}
+		if err := ss.Remove(ctx, si.Name); err != nil {
+			return removed, errors.Wrapf(err, "remove revision %s", si.Name)
+		}
+		removed = append(removed, &Revision{
+			Timestamp: si.Created,
+			Key:       si.Name,
+			Size:      usage.Size,
+		})
+	}
+	if err := relinkPrevious(ctx, ss, infos, removed); err != nil {
+		return removed, err
+	}
+	return removed, nil
+}
+
+// relinkPrevious points the kept revisions whose previous revision was removed at the
+// nearest kept revision in their history, or clears it if there is none
+func relinkPrevious(ctx context.Context, ss snapshots.Snapshotter, infos []snapshots.Info, removed []*Revision) error {
+	if len(removed) == 0 {
+		return nil
+	}
+	var (
+		gone     = make(map[string]bool)
+		previous = make(map[string]string)
+	)
+	for _, r := range removed {
+		gone[r.Key] = true
+	}
+	for _, si := range infos {
+		previous[si.Name] = si.Labels[PreviousLabel]
+	}
+	for _, si := range infos {
+		p := si.Labels[PreviousLabel]
+		if gone[si.Name] || !gone[p] {
+			continue
+		}
+		seen := make(map[string]bool)
+		for gone[p] && !seen[p] {
+			seen[p] = true
+			p = previous[p]
+		}
+		if gone[p] {
+			p = ""
+		}
+		if p == "" {
+			delete(si.Labels, PreviousLabel)
+		} else {
+			si.Labels[PreviousLabel] = p
+		}
+		if _, err := ss.Update(ctx, si, "labels."+PreviousLabel); err != nil {
+			return errors.Wrapf(err, "update previous revision of %s", si.Name)
+		}
+	}
+	return nil
+}
+
 func newRevision(id string) *Revision {
 	now := time.Now()
 	return &Revision{
@@ -142,7 +433,9 @@ func newRevision(id string) *Revision {
 type Revision struct {
 	Timestamp time.Time
 	Key       string
-	mounts    []mount.Mount
+	// Size is the disk usage of the revision's snapshot
+	Size   int64
+	mounts []mount.Mount
 }
 
 func (r *Revision) Mounts() []mount.Mount {
@@ -150,14 +443,11 @@ func (r *Revision) Mounts() []mount.Mount {
 }
 
 func create(ctx context.Context, client *containerd.Client, i containerd.Image, c *containers.Container, id string, previous string) (*Revision, error) {
-	diffIDs, err := i.RootFS(ctx)
+	parent, err := parentSnapshot(ctx, client, i, c)
 	if err != nil {
 		return nil, err
 	}
-	var (
-		parent = identity.ChainID(diffIDs).String()
-		r      = newRevision(id)
-	)
+	r := newRevision(id)
 	labels := map[string]string{
 		gcRoot:           r.Timestamp.Format(time.RFC3339),
 		ImageLabel:       i.Name(),
@@ -192,6 +482,196 @@ func save(ctx context.Context, client *containerd.Client, updatedImage container
 	return snapshot, nil
 }
 
+func saveBtrfs(ctx context.Context, client *containerd.Client, updatedImage containerd.Image, c *containers.Container, root string) (_ *Revision, err error) {
+	service := client.SnapshotService(c.Snapshotter)
+	current, err := service.Stat(ctx, c.SnapshotKey)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := create(ctx, client, updatedImage, c, c.ID, c.SnapshotKey)
+	if err != nil {
+		return nil, err
+	}
+	// do not leave a labeled revision behind without a valid subvolume
+	defer func() {
+		if err != nil {
+			if rerr := service.Remove(ctx, snapshot.Key); rerr != nil {
+				err = errors.Wrapf(err, "remove snapshot %s: %s", snapshot.Key, rerr)
+			}
+		}
+	}()
+	parent, err := parentSnapshot(ctx, client, updatedImage, c)
+	if err != nil {
+		return nil, err
+	}
+	// diff the image layers before the new snapshot is replaced
+	var imageDiff *ocispec.Descriptor
+	if parent != current.Parent {
+		desc, err := diffParents(ctx, client, c, current.Parent, parent)
+		if err != nil {
+			return nil, err
+		}
+		imageDiff = &desc
+	}
+	currentMounts, err := service.Mounts(ctx, c.SnapshotKey)
+	if err != nil {
+		return nil, err
+	}
+	currentPath, err := subvolumePath(root, currentMounts)
+	if err != nil {
+		return nil, err
+	}
+	path, err := subvolumePath(root, snapshot.mounts)
+	if err != nil {
+		return nil, err
+	}
+	// replace the new snapshot's subvolume with a snapshot of the current rw layer
+	if err := btrfs.DeleteSubvolume(path); err != nil {
+		return nil, err
+	}
+	if err := btrfs.Snapshot(currentPath, path); err != nil {
+		return nil, err
+	}
+	// the subvolume id changed with the new subvolume
+	if snapshot.mounts, err = service.Mounts(ctx, snapshot.Key); err != nil {
+		return nil, err
+	}
+	if imageDiff != nil {
+		applier := apply.NewFileSystemApplier(client.ContentStore())
+		if _, err := applier.Apply(ctx, *imageDiff, snapshot.mounts); err != nil {
+			return nil, err
+		}
+	}
+	return snapshot, nil
+}
+
+// parentSnapshot returns the committed snapshot of the image that the container's revisions
+// are prepared from, the image's ownership is shifted for remapped containers
+func parentSnapshot(ctx context.Context, client *containerd.Client, i containerd.Image, c *containers.Container) (string, error) {
+	diffIDs, err := i.RootFS(ctx)
+	if err != nil {
+		return "", err
+	}
+	parent := identity.ChainID(diffIDs).String()
+	remap := c.Labels[RemapLabel]
+	if remap == "" {
+		return parent, nil
+	}
+	var uid, gid uint32
+	if _, err := fmt.Sscanf(remap, "%d:%d", &uid, &gid); err != nil {
+		return "", errors.Wrapf(err, "invalid remap %q", remap)
+	}
+	return remapSnapshot(ctx, client, c, parent, uid, gid)
+}
+
+// remapSnapshot commits a copy of the parent with its ownership shifted by the uid and gid,
+// the copy is shared by all containers using the same image and mapping
+func remapSnapshot(ctx context.Context, client *containerd.Client, c *containers.Container, parent string, uid, gid uint32) (string, error) {
+	var (
+		service = client.SnapshotService(c.Snapshotter)
+		key     = fmt.Sprintf("%s-%d-%d", parent, uid, gid)
+		active  = fmt.Sprintf("%s-remap-%d", key, time.Now().UnixNano())
+	)
+	if _, err := service.Stat(ctx, key); err == nil {
+		return key, nil
+	} else if !errdefs.IsNotFound(err) {
+		return "", err
+	}
+	mounts, err := service.Prepare(ctx, active, parent)
+	if err != nil {
+		return "", err
+	}
+	if err := mount.WithTempMount(ctx, mounts, func(root string) error {
+		return filepath.Walk(root, shiftOwner(uid, gid))
+	}); err != nil {
+		service.Remove(ctx, active)
+		return "", errors.Wrap(err, "remap snapshot")
+	}
+	if err := service.Commit(ctx, key, active); err != nil {
+		service.Remove(ctx, active)
+		// another container committed the same mapping first
+		if errdefs.IsAlreadyExists(err) {
+			return key, nil
+		}
+		return "", err
+	}
+	return key, nil
+}
+
+func shiftOwner(uid, gid uint32) filepath.WalkFunc {
+	return func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		stat := info.Sys().(*syscall.Stat_t)
+		// lchown so that symlinks to host files are not followed
+		if err := os.Lchown(path, int(stat.Uid+uid), int(stat.Gid+gid)); err != nil {
+			return err
+		}
+		// chown clears the setuid and setgid bits
+		if info.Mode()&(os.ModeSetuid|os.ModeSetgid) != 0 && info.Mode()&os.ModeSymlink == 0 {
+			return os.Chmod(path, info.Mode())
+		}
+		return nil
+	}
+}
+
+// diffParents creates a layer with the changes between two committed snapshots
+func diffParents(ctx context.Context, client *containerd.Client, c *containers.Container, lower, upper string) (ocispec.Descriptor, error) {
+	var (
+		service = client.SnapshotService(c.Snapshotter)
+		now     = time.Now().UnixNano()
+		lkey    = fmt.Sprintf("%s-lower-%d", c.ID, now)
+		ukey    = fmt.Sprintf("%s-upper-%d", c.ID, now)
+	)
+	lowerMounts, err := service.View(ctx, lkey, lower)
+	if err != nil {
+		return ocispec.Descriptor{}, err
+	}
+	defer service.Remove(ctx, lkey)
+	upperMounts, err := service.View(ctx, ukey, upper)
+	if err != nil {
+		return ocispec.Descriptor{}, err
+	}
+	defer service.Remove(ctx, ukey)
+	return client.DiffService().Compare(ctx, lowerMounts, upperMounts,
+		diff.WithMediaType(ocispec.MediaTypeImageLayerGzip),
+		diff.WithReference(fmt.Sprintf("flux-image-diff-%s-%d", c.ID, now)),
+	)
+}
+
+// subvolumePath returns the path of the btrfs snapshotter's active subvolume for the mounts
+func subvolumePath(root string, mounts []mount.Mount) (string, error) {
+	var id string
+	for _, m := range mounts {
+		for _, o := range m.Options {
+			if strings.HasPrefix(o, "subvolid=") {
+				id = strings.TrimPrefix(o, "subvolid=")
+			}
+		}
+	}
+	if id == "" {
+		return "", errors.New("snapshot mounts do not have a btrfs subvolume id")
+	}
+	active := filepath.Join(root, "active")
+	dirs, err := ioutil.ReadDir(active)
+	if err != nil {
+		return "", err
+	}
+	for _, d := range dirs {
+		path := filepath.Join(active, d.Name())
+		rid, err := btrfs.RootID(path)
+		if err != nil {
+			// skip entries that are not readable subvolumes
+			continue
+		}
+		if rid == id {
+			return path, nil
+		}
+	}
+	return "", errors.Wrapf(errdefs.ErrNotFound, "subvolume %s in %s", id, active)
+}
+
 func previous(ctx context.Context, client *containerd.Client, c *containers.Container) (*Revision, error) {
 	service := client.SnapshotService(c.Snapshotter)
 	sInfo, err := service.Stat(ctx, c.SnapshotKey)
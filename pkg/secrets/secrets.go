@@ -0,0 +1,202 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/pkg/errors"
+)
+
+const keySize = 32
+
+var validName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// Secret is the metadata of a secret, the data is only returned by Data
+type Secret struct {
+	Name    string
+	Created time.Time
+}
+
+// Store keeps secrets encrypted with the node's key
+type Store struct {
+	root string
+	aead cipher.AEAD
+}
+
+// NewStore returns a store for the root, the node key is generated if it does not exist
+func NewStore(root, keyPath string) (*Store, error) {
+	if err := os.MkdirAll(root, 0700); err != nil {
+		return nil, err
+	}
+	key, err := loadKey(keyPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "load node key")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{
+		root: root,
+		aead: aead,
+	}, nil
+}
+
+func loadKey(path string) ([]byte, error) {
+	key, err := ioutil.ReadFile(path)
+	if err == nil {
+		if len(key) != keySize {
+			return nil, errors.Errorf("node key %s is not %d bytes", path, keySize)
+		}
+		return key, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+	key = make([]byte, keySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if _, err := f.Write(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (s *Store) Create(name string, data []byte) (*Secret, error) {
+	if !validName.MatchString(name) {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid secret name %q", name)
+	}
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	// the name is authenticated so that a secret cannot be swapped for another
+	sealed := s.aead.Seal(nonce, nonce, data, []byte(name))
+	f, err := os.OpenFile(filepath.Join(s.root, name), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		if os.IsExist(err) {
+			return nil, errors.Wrapf(errdefs.ErrAlreadyExists, "secret %s", name)
+		}
+		return nil, err
+	}
+	defer f.Close()
+	if _, err := f.Write(sealed); err != nil {
+		os.Remove(f.Name())
+		return nil, err
+	}
+	return s.Get(name)
+}
+
+func (s *Store) Get(name string) (*Secret, error) {
+	if !validName.MatchString(name) {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid secret name %q", name)
+	}
+	fi, err := os.Stat(filepath.Join(s.root, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Wrapf(errdefs.ErrNotFound, "secret %s", name)
+		}
+		return nil, err
+	}
+	return &Secret{
+		Name:    name,
+		Created: fi.ModTime(),
+	}, nil
+}
+
+// Data returns the decrypted data of the secret
+func (s *Store) Data(name string) ([]byte, error) {
+	if _, err := s.Get(name); err != nil {
+		return nil, err
+	}
+	sealed, err := ioutil.ReadFile(filepath.Join(s.root, name))
+	if err != nil {
+		return nil, err
+	}
+	size := s.aead.NonceSize()
+	if len(sealed) < size {
+		return nil, errors.Errorf("secret %s is corrupt", name)
+	}
+	data, err := s.aead.Open(nil, sealed[:size], sealed[size:], []byte(name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "decrypt secret %s", name)
+	}
+	return data, nil
+}
+
+func (s *Store) List() ([]*Secret, error) {
+	files, err := ioutil.ReadDir(s.root)
+	if err != nil {
+		return nil, err
+	}
+	var out []*Secret
+	for _, f := range files {
+		if f.IsDir() || !validName.MatchString(f.Name()) {
+			continue
+		}
+		out = append(out, &Secret{
+			Name:    f.Name(),
+			Created: f.ModTime(),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+	return out, nil
+}
+
+func (s *Store) Remove(name string) error {
+	if _, err := s.Get(name); err != nil {
+		return err
+	}
+	return os.Remove(filepath.Join(s.root, name))
+}
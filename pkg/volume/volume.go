@@ -0,0 +1,273 @@
+/*
+	Copyright (c) 2019 Stellar Project
+
+	Permission is hereby granted, free of charge, to any person
+	obtaining a copy of this software and associated documentation
+	files (the "Software"), to deal in the Software without
+	restriction, including without limitation the rights to use, copy,
+	modify, merge, publish, distribute, sublicense, and/or sell copies
+	of the Software, and to permit persons to whom the Software is
+	furnished to do so, subject to the following conditions:
+
+	The above copyright notice and this permission notice shall be
+	included in all copies or substantial portions of the Software.
+
+	THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+	EXPRESS OR IMPLIED,
+	INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+	FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+	IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT
+	HOLDERS BE LIABLE FOR ANY CLAIM,
+	DAMAGES OR OTHER LIABILITY,
+	WHETHER IN AN ACTION OF CONTRACT,
+	TORT OR OTHERWISE,
+	ARISING FROM, OUT OF OR IN CONNECTION WITH
+	THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*/
+
+package volume
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/continuity/fs"
+	"github.com/pkg/errors"
+	"github.com/stellarproject/terraos/pkg/btrfs"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	DriverBtrfs = "btrfs"
+	DriverDir   = "dir"
+
+	metadataFile = "volume.json"
+	usageTTL     = time.Minute
+)
+
+var validName = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// Volume is a named directory managed by the agent that is mounted into containers
+type Volume struct {
+	Name    string    `json:"name"`
+	Driver  string    `json:"driver"`
+	Created time.Time `json:"created"`
+	// Claimed is set once the data directory is owned by the user of the
+	// first container that mounts the volume
+	Claimed bool `json:"claimed"`
+	// Path is the data directory of the volume
+	Path string `json:"-"`
+}
+
+// DataPath returns the data directory for the named volume in the root
+func DataPath(root, name string) string {
+	return filepath.Join(root, name, "data")
+}
+
+type usage struct {
+	size int64
+	at   time.Time
+}
+
+// Store manages volumes as btrfs subvolumes, if the root is on btrfs, or as directories
+type Store struct {
+	root   string
+	driver string
+
+	mu    sync.Mutex
+	usage map[string]usage
+}
+
+func NewStore(root string) (*Store, error) {
+	if err := os.MkdirAll(root, 0711); err != nil {
+		return nil, err
+	}
+	driver := DriverDir
+	var st unix.Statfs_t
+	if err := unix.Statfs(root, &st); err != nil {
+		return nil, errors.Wrapf(err, "statfs %s", root)
+	}
+	if st.Type == unix.BTRFS_SUPER_MAGIC && btrfs.Check() == nil {
+		driver = DriverBtrfs
+	}
+	return &Store{
+		root:   root,
+		driver: driver,
+		usage:  make(map[string]usage),
+	}, nil
+}
+
+// Create creates a new volume with its data directory owned by root until it is claimed
+func (s *Store) Create(name string) (*Volume, error) {
+	if !validName.MatchString(name) {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid volume name %q", name)
+	}
+	dir := filepath.Join(s.root, name)
+	if err := os.Mkdir(dir, 0711); err != nil {
+		if os.IsExist(err) {
+			return nil, errors.Wrapf(errdefs.ErrAlreadyExists, "volume %s", name)
+		}
+		return nil, err
+	}
+	v := &Volume{
+		Name:    name,
+		Driver:  s.driver,
+		Created: time.Now(),
+		Path:    DataPath(s.root, name),
+	}
+	if err := s.create(v); err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+	return v, nil
+}
+
+func (s *Store) create(v *Volume) error {
+	switch v.Driver {
+	case DriverBtrfs:
+		if err := btrfs.CreateSubvolume(v.Path); err != nil {
+			return err
+		}
+	default:
+		if err := os.Mkdir(v.Path, 0755); err != nil {
+			return err
+		}
+	}
+	return s.writeMetadata(v)
+}
+
+// Claim changes the owner of the volume's data directory to the uid and gid
+func (s *Store) Claim(v *Volume, uid, gid int) error {
+	if err := os.Chown(v.Path, uid, gid); err != nil {
+		return err
+	}
+	v.Claimed = true
+	return s.writeMetadata(v)
+}
+
+func (s *Store) writeMetadata(v *Volume) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(s.root, v.Name, metadataFile), data, 0600)
+}
+
+func (s *Store) Get(name string) (*Volume, error) {
+	if !validName.MatchString(name) {
+		return nil, errors.Wrapf(errdefs.ErrInvalidArgument, "invalid volume name %q", name)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(s.root, name, metadataFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Wrapf(errdefs.ErrNotFound, "volume %s", name)
+		}
+		return nil, err
+	}
+	var v Volume
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	v.Path = DataPath(s.root, name)
+	return &v, nil
+}
+
+func (s *Store) List() ([]*Volume, error) {
+	dirs, err := ioutil.ReadDir(s.root)
+	if err != nil {
+		return nil, err
+	}
+	var out []*Volume
+	for _, d := range dirs {
+		if !d.IsDir() {
+			continue
+		}
+		v, err := s.Get(d.Name())
+		if err != nil {
+			if errdefs.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func (s *Store) Remove(name string) error {
+	v, err := s.Get(name)
+	if err != nil {
+		return err
+	}
+	if v.Driver == DriverBtrfs {
+		if err := btrfs.DeleteSubvolume(v.Path); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	delete(s.usage, name)
+	s.mu.Unlock()
+	return os.RemoveAll(filepath.Join(s.root, name))
+}
+
+// Usage returns the disk usage of the volume, the result is cached
+// so that listing containers does not walk their volumes every time
+func (s *Store) Usage(ctx context.Context, v *Volume) (int64, error) {
+	s.mu.Lock()
+	u, ok := s.usage[v.Name]
+	s.mu.Unlock()
+	if ok && time.Since(u.at) < usageTTL {
+		return u.size, nil
+	}
+	size, err := s.diskUsage(ctx, v)
+	if err != nil {
+		return 0, err
+	}
+	s.mu.Lock()
+	s.usage[v.Name] = usage{size: size, at: time.Now()}
+	s.mu.Unlock()
+	return size, nil
+}
+
+func (s *Store) diskUsage(ctx context.Context, v *Volume) (int64, error) {
+	if v.Driver == DriverBtrfs {
+		// quota groups report the usage without walking the subvolume when quotas are enabled
+		if size, err := qgroupUsage(v.Path); err == nil {
+			return size, nil
+		}
+	}
+	u, err := fs.DiskUsage(ctx, v.Path)
+	if err != nil {
+		return 0, err
+	}
+	return u.Size, nil
+}
+
+func qgroupUsage(path string) (int64, error) {
+	id, err := btrfs.RootID(path)
+	if err != nil {
+		return 0, err
+	}
+	out, err := exec.Command("btrfs", "qgroup", "show", "-f", "--raw", path).CombinedOutput()
+	if err != nil {
+		return 0, errors.Wrapf(err, "%s", out)
+	}
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "0/"+id {
+			continue
+		}
+		return strconv.ParseInt(fields[1], 10, 64)
+	}
+	return 0, errors.Errorf("qgroup 0/%s not found", id)
+}